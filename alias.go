@@ -0,0 +1,60 @@
+package meilisearch
+
+import "sync"
+
+// AliasStore holds the aliases of the indexes set with Client.SetAlias. The default one keeps them in memory,
+// another one can be given in Config.AliasStore, e.g. to persist them or share them between processes.
+type AliasStore interface {
+
+	// Alias returns the uid of the index alias points to, ok is false if alias is not an alias.
+	Alias(alias string) (uid string, ok bool)
+
+	// SetAlias points alias to the index uid.
+	SetAlias(alias, uid string) error
+}
+
+// memoryAliasStore is the default AliasStore
+type memoryAliasStore struct {
+	mu      sync.RWMutex
+	aliases map[string]string
+}
+
+func (s *memoryAliasStore) Alias(alias string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	uid, ok := s.aliases[alias]
+	return uid, ok
+}
+
+func (s *memoryAliasStore) SetAlias(alias, uid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.aliases[alias] = uid
+	return nil
+}
+
+// newAliasStore returns the AliasStore of a client created with config.
+func newAliasStore(config Config) AliasStore {
+	if config.AliasStore != nil {
+		return config.AliasStore
+	}
+	return &memoryAliasStore{aliases: map[string]string{}}
+}
+
+// SetAlias points alias to the index uid: the APIs of an index, such as Documents or Search, given alias work on
+// uid instead. MeiliSearch has no aliases, they only exist on the client side. Pointing the alias to another
+// index, e.g. a new version of the index built aside, switches the following calls to it.
+func (c *Client) SetAlias(alias, uid string) error {
+	return c.aliases.SetAlias(alias, uid)
+}
+
+// resolveIndex returns the uid of the index indexID is an alias of, or indexID if it is not an alias.
+func (c *Client) resolveIndex(indexID string) string {
+	if c.aliases == nil {
+		return indexID
+	}
+	if uid, ok := c.aliases.Alias(indexID); ok {
+		return uid
+	}
+	return indexID
+}
@@ -0,0 +1,64 @@
+package meilisearch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mapAliasStore map[string]string
+
+func (s mapAliasStore) Alias(alias string) (string, bool) {
+	uid, ok := s[alias]
+	return uid, ok
+}
+
+func (s mapAliasStore) SetAlias(alias, uid string) error {
+	s[alias] = uid
+	return nil
+}
+
+func TestClient_SetAlias(t *testing.T) {
+	var paths []string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+	})
+
+	search := func() {
+		if _, err := c.Search("movies").Search(SearchRequest{Query: "prince"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	search()
+	if err := c.SetAlias("movies", "movies_v1"); err != nil {
+		t.Fatal(err)
+	}
+	search()
+	if err := c.SetAlias("movies", "movies_v2"); err != nil {
+		t.Fatal(err)
+	}
+	search()
+	assert.Equal(t, []string{
+		"/indexes/movies/search",
+		"/indexes/movies_v1/search",
+		"/indexes/movies_v2/search",
+	}, paths)
+	assert.Equal(t, "movies_v2", c.Documents("movies").IndexID())
+	assert.Equal(t, "movies_v2", c.Updates("movies").IndexID())
+	assert.Equal(t, "books", c.Settings("books").(clientSettings).indexUID)
+}
+
+func TestClient_SetAlias_Store(t *testing.T) {
+	store := mapAliasStore{"movies": "movies_v1"}
+	c := newMockClient(t, Config{AliasStore: store}, func(w http.ResponseWriter, r *http.Request) {})
+
+	assert.Equal(t, "movies_v1", c.Search("movies").IndexID())
+	if err := c.SetAlias("movies", "movies_v2"); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "movies_v2", store["movies"])
+	assert.Equal(t, "movies_v2", c.Documents("movies").IndexID())
+}
@@ -1,5 +1,12 @@
 package meilisearch
 
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+)
+
 // APIWithIndexID is used to await an async update id response.
 // Each apis that use an index internally implement this interface except APIUpdates.
 type APIWithIndexID interface {
@@ -25,6 +32,8 @@ type APIIndexes interface {
 	// If no UID is specified in the request a randomly generated UID will be returned.
 	// It's associated to the new index. This UID will be essential to make all request over the created index.
 	// You can define your primary key during the index creation
+	// Newer MeiliSearch versions create the index asynchronously, the returned AsyncUpdateID is then the update
+	// to wait for before using the index, see Client.CreateIndexWithUpdate.
 	Create(request CreateIndexRequest) (*CreateIndexResponse, error)
 
 	// Update an index name.
@@ -35,10 +44,19 @@ type APIIndexes interface {
 
 	// Delete an index.
 	Delete(uid string) (bool, error)
+
+	// ChangedSince tells whether the index has been updated after since, based on its updatedAt.
+	ChangedSince(uid string, since time.Time) (bool, error)
+
+	// Swap exchanges the documents, settings and updates of two indexes, see ReindexAtomic.
+	Swap(uid string, otherUID string) (*AsyncUpdateID, error)
 }
 
 // APIDocuments are objects composed of fields containing any data.
 //
+// Documents are encoded and decoded with encoding/json, so the json tags of the documents fields (names,
+// omitempty, "-") are honored. No meilisearch specific tag is read.
+//
 // Documentation: https://docs.meilisearch.com/references/documents.html
 type APIDocuments interface {
 
@@ -46,6 +64,11 @@ type APIDocuments interface {
 	// documentPtr should be a pointer.
 	Get(identifier string, documentPtr interface{}) error
 
+	// GetInto gets one document using its unique identifier and decodes it with json.Unmarshal into dest, which
+	// can be a pointer to any struct or map, without implementing json.Unmarshaler. See Config.UseJSONNumber.
+	// A missing document is reported as an *Error with the http.StatusNotFound StatusCode.
+	GetInto(identifier string, dest interface{}) error
+
 	// Delete one document based on its unique identifier.
 	Delete(identifier string) (*AsyncUpdateID, error)
 
@@ -55,16 +78,46 @@ type APIDocuments interface {
 	// List the documents in an unordered way.
 	List(request ListDocumentsRequest, documentsPtr interface{}) error
 
+	// Count returns the number of documents of the index, read from its stats without fetching any document.
+	Count() (int64, error)
+
+	// GetMany gets the documents with the given identifiers in a single request, the missing ones being left out.
+	// documentsPtr should be a pointer to a slice.
+	GetMany(identifiers []string, documentsPtr interface{}) error
+
+	// EachDocument calls fn with each document of the index, as raw JSON, listing them page by page from
+	// request.Offset until a page is shorter than request.Limit, which is the page size and defaults to 1000.
+	// The iteration stops at the first error, returned by a request or by fn.
+	EachDocument(request ListDocumentsRequest, fn func(document []byte) error) error
+
 	// AddOrReplace a list of documents, replace them if they already exist based on their unique identifiers.
-	AddOrReplace(documentsPtr interface{}) (*AsyncUpdateID, error)
+	// Options such as WithPrimaryKey or WithCSV change how the documents are sent.
+	AddOrReplace(documentsPtr interface{}, opts ...DocumentsOption) (*AsyncUpdateID, error)
+
+	// AddOrReplaceNDJSON adds or replaces the documents read from r as NDJSON, one document per line. r is
+	// streamed to MeiliSearch without being buffered, so it can be bigger than the available memory.
+	AddOrReplaceNDJSON(r io.Reader, opts ...DocumentsOption) (*AsyncUpdateID, error)
+
+	// AddOrReplaceCSV adds or replaces the documents read from r as CSV, whose header names the fields. r is
+	// streamed to MeiliSearch, the primary key and the delimiter can be set with WithPrimaryKey and
+	// WithCSVDelimiter.
+	AddOrReplaceCSV(r io.Reader, opts ...DocumentsOption) (*AsyncUpdateID, error)
 
 	// AddOrReplaceWithPrimaryKey do the same as AddOrReplace but will specify during the update to primaryKey to use for indexing
+	//
+	// Deprecated: use AddOrReplace with WithPrimaryKey.
 	AddOrReplaceWithPrimaryKey(documentsPtr interface{}, primaryKey string) (resp *AsyncUpdateID, err error)
 
 	// AddOrUpdate a list of documents, update them if they already exist based on their unique identifiers.
-	AddOrUpdate(documentsPtr interface{}) (*AsyncUpdateID, error)
+	// Options such as WithPrimaryKey or WithCSV change how the documents are sent.
+	AddOrUpdate(documentsPtr interface{}, opts ...DocumentsOption) (*AsyncUpdateID, error)
+
+	// AddOrUpdateCSV adds or updates the documents read from r as CSV, see AddOrReplaceCSV.
+	AddOrUpdateCSV(r io.Reader, opts ...DocumentsOption) (*AsyncUpdateID, error)
 
 	// AddOrUpdateWithPrimaryKey do the same as AddOrUpdate but will specify during the update to primaryKey to use for indexing
+	//
+	// Deprecated: use AddOrUpdate with WithPrimaryKey.
 	AddOrUpdateWithPrimaryKey(documentsPtr interface{}, primaryKey string) (resp *AsyncUpdateID, err error)
 
 	// DeleteAllDocuments in the specified index.
@@ -81,6 +134,35 @@ type APISearch interface {
 	// Search for documents matching a specific query in the given index.
 	Search(params SearchRequest) (*SearchResponse, error)
 
+	// SearchRaw searches like Search, but returns the response body as received, e.g. to pass it as is to a
+	// frontend. The errors are reported as with Search.
+	SearchRaw(params SearchRequest) ([]byte, error)
+
+	// SearchGet searches like Search, but with a GET request whose parameters are in the url, so that the
+	// response can be cached by proxies. The slices are comma-joined and the FacetFilters are sent as JSON.
+	SearchGet(params SearchRequest) (*SearchResponse, error)
+
+	// FacetSearch searches the values of a facet, e.g. to suggest them while the user types.
+	FacetSearch(request FacetSearchRequest) (*FacetSearchResponse, error)
+
+	// SearchWithFallback searches like Search, but if ctx is done before the response, MeiliSearch cannot be
+	// reached or does not reply within Config.Timeout, or it replies with a 5xx status, it returns the fallback
+	// hits instead (e.g. the last known results) with Degraded set. The search is aborted once ctx is done.
+	SearchWithFallback(ctx context.Context, request SearchRequest, fallback []interface{}) (*SearchResponse, error)
+
+	// NotDisplayedAttributes returns the attributes of attributesToRetrieve which are not displayed attributes,
+	// MeiliSearch silently leaves them out of the hits. The displayed attributes are read from the settings
+	// cached by the client, see Config.SettingsCacheTTL.
+	NotDisplayedAttributes(attributesToRetrieve []string) ([]string, error)
+
+	// StableExport calls fn with every page of hits matching request, request.Limit hits at a time.
+	// The hits are sorted on primaryKey, which must be sortable and filterable, and each page is filtered to
+	// start after the last primary key seen instead of using an offset. So documents added or deleted during the
+	// export do not shift the pages, no document is returned twice or skipped. As MeiliSearch only compares
+	// numbers, the primary keys must be numbers, an error is returned otherwise. request.Offset must not be set,
+	// nor request.Filters which is not read along with sort, request.Filter is used instead.
+	StableExport(ctx context.Context, request SearchRequest, primaryKey string, fn func(hits []interface{}) error) error
+
 	APIWithIndexID
 }
 
@@ -104,13 +186,61 @@ type APIUpdates interface {
 	APIWithIndexID
 }
 
+// APIBatches MeiliSearch groups the tasks it processes together into batches, the batch of an update is
+// given by its BatchUID. It allows following a bulk import made of many updates as a single entity.
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/batches
+type APIBatches interface {
+
+	// Get a batch by its uid.
+	Get(uid int64) (*Batch, error)
+
+	// List the batches, most recent first.
+	List() (*BatchesResponse, error)
+}
+
+// APIDumps creates dumps of the whole MeiliSearch database, e.g. for backups or to migrate to a new version.
+// A dump is created asynchronously, its status has to be polled with GetStatus, see Client.WaitForDump.
+//
+// Documentation: https://docs.meilisearch.com/references/dump.html
+type APIDumps interface {
+
+	// Create starts the creation of a dump, the returned uid identifies it.
+	Create() (*Dump, error)
+
+	// GetStatus gets the status of the creation of a dump.
+	GetStatus(uid string) (*Dump, error)
+}
+
 // APIKeys To communicate with MeiliSearch's RESTfull API most of the routes require an API key.
 //
 // Documentation: https://docs.meilisearch.com/references/keys.html
 type APIKeys interface {
 
 	// Get all keys.
+	// With Config.KeysCacheTTL, keys are served from a copy held by the client until it expires, see Refresh.
 	Get() (*Keys, error)
+
+	// Refresh fetches the keys again and replaces the copy held by the client, if any.
+	// The default keys are derived from the master key, so Refresh must be called after a master key change.
+	Refresh() (*Keys, error)
+
+	// CreateKey creates a key allowed to perform the given actions on the given indexes, e.g. a key scoped to
+	// the index of a tenant. Actions are checked against the ones known by MeiliSearch before sending the request.
+	CreateKey(request CreateKeyRequest) (*Key, error)
+
+	// GetKey gets a key from its value or its uid.
+	GetKey(keyOrUID string) (*Key, error)
+
+	// ListKeys lists the keys with their actions and indexes.
+	ListKeys() (*KeysResults, error)
+
+	// UpdateKey updates the name and the description of a key, its actions, indexes and expiration can not be
+	// changed.
+	UpdateKey(keyOrUID string, request UpdateKeyRequest) (*Key, error)
+
+	// DeleteKey deletes a key, the requests using it are then rejected.
+	DeleteKey(keyOrUID string) error
 }
 
 // APISettings allow to configure the MeiliSearch indexing & search behaviour.
@@ -119,6 +249,10 @@ type APIKeys interface {
 type APISettings interface {
 	GetAll() (*Settings, error)
 
+	// GetRaw returns the response of a single setting as is, the setting being named as in Settings json
+	// (e.g. "rankingRules" or "stopWords").
+	GetRaw(setting string) (json.RawMessage, error)
+
 	UpdateAll(request Settings) (*AsyncUpdateID, error)
 
 	ResetAll() (*AsyncUpdateID, error)
@@ -157,6 +291,10 @@ type APISettings interface {
 
 	UpdateSynonyms(synonyms map[string][]string) (*AsyncUpdateID, error)
 
+	// ImportSynonymsFromReader updates the synonyms from a CSV or TSV file, e.g. exported from a spreadsheet.
+	// Each row is a group of terms which are all synonyms of each other.
+	ImportSynonymsFromReader(r io.Reader, format SynonymFormat) (*AsyncUpdateID, error)
+
 	ResetSynonyms() (*AsyncUpdateID, error)
 
 	GetAttributesForFaceting() (*[]string, error)
@@ -164,6 +302,40 @@ type APISettings interface {
 	UpdateAttributesForFaceting([]string) (*AsyncUpdateID, error)
 
 	ResetAttributesForFaceting() (*AsyncUpdateID, error)
+
+	// GetSortableAttributes gets the attributes which can be used in the Sort of a SearchRequest.
+	GetSortableAttributes() (*[]string, error)
+
+	UpdateSortableAttributes([]string) (*AsyncUpdateID, error)
+
+	ResetSortableAttributes() (*AsyncUpdateID, error)
+
+	GetPagination() (*Pagination, error)
+
+	UpdatePagination(Pagination) (*AsyncUpdateID, error)
+
+	ResetPagination() (*AsyncUpdateID, error)
+
+	// GetTypoTolerance gets the typo tolerance settings, e.g. the attributes on which typos are not allowed.
+	GetTypoTolerance() (*TypoTolerance, error)
+
+	// UpdateTypoTolerance updates the typo tolerance settings, the fields left empty are unchanged.
+	UpdateTypoTolerance(TypoTolerance) (*AsyncUpdateID, error)
+
+	ResetTypoTolerance() (*AsyncUpdateID, error)
+
+	// GetFaceting gets the faceting settings, i.e. the maximum number of values returned for each facet.
+	GetFaceting() (*Faceting, error)
+
+	// UpdateFaceting updates the faceting settings, e.g. to return more than 100 values for each facet.
+	UpdateFaceting(Faceting) (*AsyncUpdateID, error)
+
+	ResetFaceting() (*AsyncUpdateID, error)
+
+	// UpdateWithRollback calls update, which can make several settings calls, after taking a snapshot of the
+	// settings. If update fails, the snapshot is applied again in a single update so that the index is not left
+	// half-updated, and a *RollbackError matching the error of update with errors.Is and errors.As is returned.
+	UpdateWithRollback(update func(settings APISettings) error) error
 }
 
 // APIStats retrieve statistic over all indexes or a specific index id.
@@ -171,10 +343,26 @@ type APISettings interface {
 // Documentation: https://docs.meilisearch.com/references/stats.html
 type APIStats interface {
 
-	// Get stats of an index.
+	// Get stats of an index, e.g. to check whether it IsIndexing before searching it.
 	Get(indexUID string) (*StatsIndex, error)
 
 	GetAll() (*Stats, error)
+
+	// TotalDocuments returns the number of documents of all the indexes, summed from the stats of GetAll.
+	TotalDocuments() (int64, error)
+
+	// IndexStatsMany get the stats of several indexes concurrently, which is faster than GetAll when only a
+	// subset of the indexes matters. If some indexes fail, the stats of the others are returned along with
+	// an IndexErrors.
+	IndexStatsMany(uids []string) (map[string]*StatsIndex, error)
+
+	// IndexStatsManyWithContext is IndexStatsMany within the deadline of ctx, shared with a RequestBudget.
+	// The indexes not fetched in time fail with context.DeadlineExceeded in the IndexErrors.
+	IndexStatsManyWithContext(ctx context.Context, uids []string) (map[string]*StatsIndex, error)
+
+	// WatchStats polls the stats of all indexes every interval and sends them only when they changed since the
+	// last value sent. Failed polls are sent on the error channel. Both channels are closed once ctx is done.
+	WatchStats(ctx context.Context, interval time.Duration) (<-chan Stats, <-chan error)
 }
 
 // APIHealth handle health of a MeiliSearch server.
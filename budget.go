@@ -0,0 +1,51 @@
+package meilisearch
+
+import (
+	"context"
+	"time"
+)
+
+// RequestBudget shares the deadline of a context between the sub-requests of a fan-out, e.g. getting the stats of
+// many indexes. Each sub-request is given what is left of the budget when it starts, so later sub-requests get
+// shorter timeouts and once the budget is exhausted the remaining ones fail without being sent.
+type RequestBudget struct {
+	ctx context.Context
+}
+
+// NewRequestBudget returns a RequestBudget spending the time left before the deadline of ctx.
+// Without deadline the budget is unlimited, the sub-requests only stop when ctx is cancelled.
+func NewRequestBudget(ctx context.Context) *RequestBudget {
+	return &RequestBudget{ctx: ctx}
+}
+
+// Remaining returns the time left in the budget, or -1 if it has no deadline.
+func (b *RequestBudget) Remaining() time.Duration {
+	deadline, ok := b.ctx.Deadline()
+	if !ok {
+		return -1
+	}
+	if remaining := time.Until(deadline); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Next allocates the context of a sub-request, which must be cancelled once the sub-request is done.
+// It returns the error of the context right away when the budget is exhausted.
+func (b *RequestBudget) Next() (context.Context, context.CancelFunc, error) {
+	if err := b.ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	remaining := b.Remaining()
+	switch {
+	case remaining < 0:
+		ctx, cancel := context.WithCancel(b.ctx)
+		return ctx, cancel, nil
+	case remaining == 0:
+		return nil, nil, context.DeadlineExceeded
+	default:
+		ctx, cancel := context.WithTimeout(b.ctx, remaining)
+		return ctx, cancel, nil
+	}
+}
@@ -0,0 +1,56 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Millisecond)
+	defer cancel()
+	budget := NewRequestBudget(ctx)
+
+	var timeouts []time.Duration
+	for i := 0; i < 3; i++ {
+		subCtx, subCancel, err := budget.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		deadline, ok := subCtx.Deadline()
+		assert.True(t, ok)
+		timeouts = append(timeouts, time.Until(deadline))
+		subCancel()
+
+		// the sub-request spends some of the budget
+		time.Sleep(15 * time.Millisecond)
+	}
+	assert.Greater(t, int64(timeouts[0]), int64(timeouts[1]))
+	assert.Greater(t, int64(timeouts[1]), int64(timeouts[2]))
+	assert.LessOrEqual(t, int64(timeouts[0]), int64(60*time.Millisecond))
+
+	time.Sleep(budget.Remaining())
+	_, _, err := budget.Next()
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, time.Duration(0), budget.Remaining())
+}
+
+func TestRequestBudget_NoDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	budget := NewRequestBudget(ctx)
+	assert.Equal(t, time.Duration(-1), budget.Remaining())
+
+	subCtx, subCancel, err := budget.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok := subCtx.Deadline()
+	assert.False(t, ok)
+	subCancel()
+
+	cancel()
+	_, _, err = budget.Next()
+	assert.Equal(t, context.Canceled, err)
+}
@@ -1,10 +1,25 @@
 package meilisearch
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/tls"
 	"github.com/pkg/errors"
 	"github.com/valyala/fasthttp"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
 	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"encoding/json"
@@ -17,14 +32,185 @@ type Config struct {
 	// Example: 'http://localhost:7700'
 	Host string
 
+	// ReadHost, if set, receives the requests which do not change anything, GET requests and searches, e.g. to
+	// send them to a read replica. It defaults to Host. The status of the updates, tasks, batches and dumps, and
+	// the reads done before a write, such as the snapshot of UpdateWithRollback, are sent to WriteHost.
+	ReadHost string
+
+	// WriteHost, if set, receives the requests changing something, e.g. to send them to the primary of a
+	// replicated deployment. It defaults to Host.
+	WriteHost string
+
 	// APIKey is optional
 	APIKey string
+
+	// APIKeyHeader is the name of the header the APIKey is sent in, DefaultAPIKeyHeader if empty.
+	// It allows going through proxies expecting the key in another header.
+	APIKeyHeader string
+
+	// Headers are added to every request, e.g. for a proxy requiring a tenant or tracing header. They can not
+	// replace the Content-Type nor the API key header, which are set by the client.
+	Headers map[string]string
+
+	// MaxResponseBodySize is the maximum size in bytes of a response body, a response bigger than
+	// this is rejected with ErrCodeResponseBodyTooLarge. Zero means no limit.
+	MaxResponseBodySize int
+
+	// CanonicalJSON makes request bodies deterministic: object keys are sorted and no insignificant
+	// whitespace is kept, so the same request always produces the same bytes (e.g. to sign them).
+	CanonicalJSON bool
+
+	// ValidateRankingRules makes the settings methods check ranking rules with ValidateRankingRules before
+	// sending them, so that a typo is reported instead of silently degrading the relevancy.
+	ValidateRankingRules bool
+
+	// ValidateFilters makes the search methods check that the attributes of SearchRequest.Filter are filterable,
+	// so that the attributes which are not are named instead of the search failing. The filterable attributes are
+	// read from the settings cached by the client. The legacy SearchRequest.Filters are not checked.
+	ValidateFilters bool
+
+	// CompressRequest compresses the request bodies with gzip, which saves bandwidth with big documents sent to a
+	// remote server, and asks for gzip responses. The gzip responses are decompressed whether it is set or not.
+	CompressRequest bool
+
+	// UseJSONNumber makes the document decoding helpers, such as APIDocuments.GetInto, decode the numbers held by
+	// interface{} values as json.Number instead of float64, so that big integers keep their precision.
+	UseJSONNumber bool
+
+	// TLSConfig is used by NewClient for https hosts, e.g. to provide client certificates or a custom CA pool.
+	TLSConfig *tls.Config
+
+	// InsecureSkipVerify disables the verification of the server certificate in NewClient, on top of TLSConfig.
+	// It must only be used in development, e.g. with a self-signed certificate.
+	InsecureSkipVerify bool
+
+	// SettingsCacheTTL is how long the settings used by the client side checks, such as
+	// APISearch.NotDisplayedAttributes, are kept before being fetched again. It defaults to
+	// DefaultSettingsCacheTTL. The cache of an index is dropped when its settings are changed through the Client,
+	// and the settings are not cached again until the update changing them is processed.
+	SettingsCacheTTL time.Duration
+
+	// KeysCacheTTL is how long the keys fetched by APIKeys.Get are kept before being fetched again, see
+	// APIKeys.Refresh. The keys are not cached if zero, the default.
+	KeysCacheTTL time.Duration
+
+	// Dial is used by NewClient to open the connections to the host, e.g. to go through a SOCKS proxy or to use
+	// a custom resolver. addr is the host and port of the Host url. The fasthttp default dialer is used if nil.
+	Dial func(addr string) (net.Conn, error)
+
+	// AliasStore holds the aliases set with Client.SetAlias, they are kept in memory if nil.
+	AliasStore AliasStore
+
+	// Timeout is the maximum duration of a request, a request taking longer fails with an error matching
+	// fasthttp.ErrTimeout with errors.Is. When a request also has a context deadline, see Client.WithContext, the
+	// sooner applies. Zero means no timeout: a request can then block as long as the server does not respond.
+	Timeout time.Duration
+
+	// Retry configures the retries of the requests failing because of a connection error or a 5xx status, e.g.
+	// while MeiliSearch restarts during a deploy. Requests are not retried by default.
+	Retry RetryConfig
+
+	// RequestObserver, if set, is called after each attempt of each request, e.g. to measure the latencies
+	// and the retry rate. It is called synchronously, so it must be fast.
+	RequestObserver RequestObserver
+
+	// PollJitter spreads the polls of the wait helpers, such as WaitForPendingUpdate and WaitForAll, by randomizing
+	// each interval by up to PollJitter of it, e.g. 0.2 for ±20%, so that clients waiting for the same updates do
+	// not poll in sync. Zero disables it.
+	PollJitter float64
+}
+
+// RequestObserver is notified of each attempt of a request, see Config.RequestObserver.
+type RequestObserver func(event RequestEvent)
+
+// RetryConfig configures the retries of the requests failing because of a connection error or a 5xx status.
+// Only the idempotent requests (GET, PUT and DELETE) are retried, unless RetryPOST is set: retrying a POST, such
+// as a document addition, could apply it twice. Requests whose body is streamed from an io.Reader are never
+// retried, their body can only be read once.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times a request is sent, 0 or 1 disables the retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, it doubles at each retry. It defaults to
+	// DefaultRetryBaseDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, zero means no cap.
+	MaxDelay time.Duration
+
+	// Jitter randomizes each delay by up to Jitter of it, e.g. 0.2 for ±20%, so that clients do not retry in sync.
+	Jitter float64
+
+	// RetryPOST also retries the POST requests.
+	RetryPOST bool
+
+	// MaxElapsedTime bounds the time spent retrying a request, zero means no bound: a request is not sent again
+	// if the next attempt would start later than MaxElapsedTime after the first one, even if MaxAttempts is not
+	// reached. The error of the last attempt is returned.
+	MaxElapsedTime time.Duration
+}
+
+// DefaultRetryBaseDelay is the default RetryConfig.BaseDelay
+const DefaultRetryBaseDelay = 100 * time.Millisecond
+
+// attempts returns the maximum number of times req can be sent.
+func (r RetryConfig) attempts(req internalRequest) int {
+	if r.MaxAttempts <= 1 {
+		return 1
+	}
+	if _, streamed := req.withRequest.(io.Reader); streamed {
+		return 1
+	}
+	switch req.method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return r.MaxAttempts
+	case http.MethodPost:
+		if r.RetryPOST {
+			return r.MaxAttempts
+		}
+	}
+	return 1
+}
+
+// delay returns the delay before sending a request again after its attempt-th attempt failed.
+func (r RetryConfig) delay(attempt int) time.Duration {
+	delay := r.BaseDelay
+	if delay <= 0 {
+		delay = DefaultRetryBaseDelay
+	}
+	for i := 1; i < attempt && (r.MaxDelay <= 0 || delay < r.MaxDelay); i++ {
+		delay *= 2
+	}
+	if r.MaxDelay > 0 && delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	return jitter(delay, r.Jitter)
 }
 
 // ClientInterface is interface for all Meilisearch client
 type ClientInterface interface {
 	WaitForPendingUpdate(ctx context.Context, interval time.Duration, indexID string, updateID *AsyncUpdateID) (UpdateStatus, error)
 	DefaultWaitForPendingUpdate(indexUID string, updateID *AsyncUpdateID) (UpdateStatus, error)
+	WaitForDump(ctx context.Context, interval time.Duration, uid string) (DumpStatus, error)
+	WaitForPendingUpdateAsync(interval time.Duration, indexID string, updateID *AsyncUpdateID) (<-chan WaitResult, context.CancelFunc)
+	WaitForSearchable(ctx context.Context, indexID, docID string, interval time.Duration) error
+	WaitForAll(ctx context.Context, indexID string, updateIDs []*AsyncUpdateID, concurrency int) (*BatchResult, error)
+	ForceReindex(ctx context.Context, indexID string) (*Update, error)
+	CopySettings(srcUID, dstUID string) (*AsyncUpdateID, error)
+	CreateIndexWithUpdate(request CreateIndexRequest) (*CreateIndexResponse, *Update, error)
+	ExportRelevanceConfig(indexID string) ([]byte, error)
+	ImportRelevanceConfig(indexID string, data []byte) ([]*AsyncUpdateID, error)
+	ApplySettingsFromReader(indexID string, r io.Reader) (*AsyncUpdateID, error)
+	DetectNewFields(indexID string) ([]string, error)
+	ListModifiedSince(ctx context.Context, indexID string, field string, since time.Time, out interface{}) error
+	DumpDocumentsToFile(ctx context.Context, indexID, path string) (int64, error)
+	Diagnostics(ctx context.Context) (*DiagnosticsReport, error)
+	RawHTTPClient() *fasthttp.Client
+	PendingTaskCount(indexID string) (int, error)
+	TotalPendingTaskCount() (int, error)
+	WithResponseHeaders(headers *map[string]string) ClientInterface
+	WithContext(ctx context.Context) ClientInterface
+	SetAlias(alias, uid string) error
 
 	Indexes() APIIndexes
 	Version() APIVersion
@@ -35,6 +221,8 @@ type ClientInterface interface {
 	Keys() APIKeys
 	Stats() APIStats
 	Health() APIHealth
+	Batches() APIBatches
+	Dumps() APIDumps
 }
 
 // Client is a structure that give you the power for interacting with an high-level api with meilisearch.
@@ -48,6 +236,24 @@ type Client struct {
 	apiStats   APIStats
 	apiHealth  APIHealth
 	apiVersion APIVersion
+	apiBatches APIBatches
+	apiDumps   APIDumps
+
+	settingsCache *settingsCache
+
+	// responseHeaders is filled with the headers of each response, see WithResponseHeaders
+	responseHeaders *map[string]string
+
+	// ctx is the context of the requests, see WithContext
+	ctx context.Context
+
+	aliases AliasStore
+
+	// pool spreads the reads across several hosts, see NewClientPool
+	pool *hostPool
+
+	// primary sends the reads to the write host too, see onPrimary
+	primary bool
 }
 
 // Indexes return an APIIndexes client.
@@ -62,22 +268,22 @@ func (c *Client) Version() APIVersion {
 
 // Documents return an APIDocuments client.
 func (c *Client) Documents(indexID string) APIDocuments {
-	return newClientDocuments(c, indexID)
+	return newClientDocuments(c, c.resolveIndex(indexID))
 }
 
 // Search return an APISearch client.
 func (c *Client) Search(indexID string) APISearch {
-	return newClientSearch(c, indexID)
+	return newClientSearch(c, c.resolveIndex(indexID))
 }
 
 // Updates return an APIUpdates client.
 func (c *Client) Updates(indexID string) APIUpdates {
-	return newClientUpdates(c, indexID)
+	return newClientUpdates(c, c.resolveIndex(indexID))
 }
 
 // Settings return an APISettings client.
 func (c *Client) Settings(indexID string) APISettings {
-	return newClientSettings(c, indexID)
+	return newClientSettings(c, c.resolveIndex(indexID))
 }
 
 // Keys return an APIKeys client.
@@ -95,11 +301,86 @@ func (c *Client) Health() APIHealth {
 	return c.apiHealth
 }
 
+// RawHTTPClient returns the fasthttp.Client sending the requests, e.g. to change its TLS config without creating
+// a new Client. The fasthttp.Client is shared by all the requests: its fields must not be changed while requests
+// are in flight, as fasthttp does not synchronize their access. Some of them, like the TLS config, are only used
+// when opening new connections, so already open connections keep the previous values.
+func (c *Client) RawHTTPClient() *fasthttp.Client {
+	return c.httpClient
+}
+
+// Batches return an APIBatches client.
+func (c *Client) Batches() APIBatches {
+	return c.apiBatches
+}
+
+// Dumps return an APIDumps client.
+func (c *Client) Dumps() APIDumps {
+	return c.apiDumps
+}
+
+// WithResponseHeaders returns a copy of the client which fills headers with the headers of the responses it
+// receives, e.g. to read the request id of a specific call:
+//
+//	var headers map[string]string
+//	_, err := client.WithResponseHeaders(&headers).Search("movies").Search(request)
+//
+// headers is replaced by the headers of each response, including the failed ones, so the copy is meant to be
+// used for a single call. It shares the connections and caches of the client.
+func (c *Client) WithResponseHeaders(headers *map[string]string) ClientInterface {
+	clone := c.clone()
+	clone.responseHeaders = headers
+	return clone
+}
+
+// WithContext returns a copy of the client sending all its requests within ctx: they are aborted, with an error
+// matching ctx.Err() with errors.Is, once ctx is done. It shares the connections and caches of the client.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	resp, err := client.WithContext(ctx).Search("movies").Search(request)
+func (c *Client) WithContext(ctx context.Context) ClientInterface {
+	clone := c.clone()
+	clone.ctx = ctx
+	return clone
+}
+
+// onPrimary returns a copy of the client sending all its requests to Config.WriteHost, or to the primary of a
+// pool, for the reads which must not miss the latest writes, e.g. a snapshot of the settings about to be changed.
+func (c *Client) onPrimary() *Client {
+	clone := c.clone()
+	clone.primary = true
+	return clone
+}
+
+// readsReplica tells if req can be sent to Config.ReadHost or to any host of a pool.
+func (c *Client) readsReplica(req *internalRequest) bool {
+	return req.reads() && !c.primary
+}
+
+// clone returns a copy of the client sharing its http client and caches, whose APIs use the copy.
+func (c *Client) clone() *Client {
+	clone := *c
+	clone.apiIndexes = newClientIndexes(&clone)
+	clone.apiKeys = newClientKeys(&clone)
+	if keys, ok := c.apiKeys.(clientKeys); ok {
+		clone.apiKeys = clientKeys{client: &clone, cache: keys.cache}
+	}
+	clone.apiHealth = newClientHealth(&clone)
+	clone.apiStats = newClientStats(&clone)
+	clone.apiVersion = newClientVersion(&clone)
+	clone.apiBatches = newClientBatches(&clone)
+	clone.apiDumps = newClientDumps(&clone)
+	return &clone
+}
+
 // NewFastHTTPCustomClient creates Meilisearch with custom fasthttp.Client
 func NewFastHTTPCustomClient(config Config, client *fasthttp.Client) ClientInterface {
 	c := &Client{
-		config:     config,
-		httpClient: client,
+		config:        config,
+		httpClient:    client,
+		settingsCache: newSettingsCache(),
+		aliases:       newAliasStore(config),
 	}
 
 	c.apiIndexes = newClientIndexes(c)
@@ -107,19 +388,36 @@ func NewFastHTTPCustomClient(config Config, client *fasthttp.Client) ClientInter
 	c.apiHealth = newClientHealth(c)
 	c.apiStats = newClientStats(c)
 	c.apiVersion = newClientVersion(c)
+	c.apiBatches = newClientBatches(c)
+	c.apiDumps = newClientDumps(c)
 
 	return c
 }
 
 // NewClient creates Meilisearch with default fasthttp.Client
 func NewClient(config Config) ClientInterface {
+	tlsConfig := config.TLSConfig
+	if config.InsecureSkipVerify {
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		} else {
+			tlsConfig = tlsConfig.Clone()
+		}
+		tlsConfig.InsecureSkipVerify = true
+	}
+
 	client := &fasthttp.Client{
-		Name: "meilsearch-client",
+		Name:                "meilsearch-client",
+		MaxResponseBodySize: config.MaxResponseBodySize,
+		TLSConfig:           tlsConfig,
+		Dial:                config.Dial,
 	}
 
 	c := &Client{
-		config:     config,
-		httpClient: client,
+		config:        config,
+		httpClient:    client,
+		settingsCache: newSettingsCache(),
+		aliases:       newAliasStore(config),
 	}
 
 	c.apiIndexes = newClientIndexes(c)
@@ -127,10 +425,23 @@ func NewClient(config Config) ClientInterface {
 	c.apiHealth = newClientHealth(c)
 	c.apiStats = newClientStats(c)
 	c.apiVersion = newClientVersion(c)
+	c.apiBatches = newClientBatches(c)
+	c.apiDumps = newClientDumps(c)
 
 	return c
 }
 
+const contentTypeJSON = "application/json"
+
+// DefaultSettingsCacheTTL is the default Config.SettingsCacheTTL
+const DefaultSettingsCacheTTL = time.Minute
+
+// defaultPollInterval is the interval between two checks of an update when none is given
+const defaultPollInterval = 50 * time.Millisecond
+
+// DefaultAPIKeyHeader is the header MeiliSearch reads the API key from
+const DefaultAPIKeyHeader = "X-Meili-API-Key"
+
 type internalRequest struct {
 	endpoint string
 	method   string
@@ -139,14 +450,37 @@ type internalRequest struct {
 	withResponse    interface{}
 	withQueryParams map[string]string
 
+	// contentType of the request body, when it is not JSON the body is sent as is and withRequest must be
+	// a []byte, a string or an io.Reader.
+	contentType string
+
+	// contentEncoding of the request body, e.g. gzip, the body is then sent as is as for contentType.
+	contentEncoding string
+
 	acceptedStatusCodes []int
 
 	functionName string
 	apiName      string
+
+	// host the request is sent to instead of the one chosen by Client.host
+	host string
 }
 
-func (c *Client) executeRequest(req internalRequest) error {
-	internalError := &Error{
+// reads tells if req only reads data, such a request can be sent to Config.ReadHost. The status of the updates,
+// tasks, batches and dumps is not: a replica may not know yet the ones just created on the primary.
+func (req *internalRequest) reads() bool {
+	switch req.apiName {
+	case "Search":
+		return true
+	case "Updates", "Tasks", "Batches", "Dumps":
+		return false
+	}
+	return req.method == http.MethodGet
+}
+
+// newError returns the Error describing a failure of req.
+func newError(req internalRequest) *Error {
+	return &Error{
 		Endpoint:           req.endpoint,
 		Method:             req.method,
 		Function:           req.functionName,
@@ -156,28 +490,124 @@ func (c *Client) executeRequest(req internalRequest) error {
 		MeilisearchMessage: "empty meilisearch message",
 		StatusCodeExpected: req.acceptedStatusCodes,
 	}
+}
+
+func (c *Client) executeRequest(req internalRequest) error {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return c.executeRequestContext(ctx, req)
+}
+
+// executeRequestContext executes req within ctx, the request is aborted once ctx is done.
+// It is retried as configured by Config.Retry.
+func (c *Client) executeRequestContext(ctx context.Context, req internalRequest) error {
+	attempts := c.config.Retry.attempts(req)
+	begin := time.Now()
+	for attempt := 1; ; attempt++ {
+		started := time.Now()
+		var statusCode int
+		var err error
+		if c.pool != nil && c.readsReplica(&req) && req.host == "" {
+			statusCode, err = c.executePooledAttempt(ctx, req)
+		} else {
+			statusCode, err = c.executeAttempt(ctx, req)
+		}
+		final := err == nil || attempt >= attempts || !c.isTransient(ctx, err)
+		var delay time.Duration
+		if !final {
+			delay = c.config.Retry.delay(attempt)
+			maxElapsed := c.config.Retry.MaxElapsedTime
+			final = maxElapsed > 0 && time.Since(begin)+delay > maxElapsed
+		}
+		c.observe(RequestEvent{
+			Method:     req.method,
+			Endpoint:   req.endpoint,
+			Function:   req.apiName + "." + req.functionName,
+			Attempt:    attempt,
+			Final:      final,
+			StatusCode: statusCode,
+			Duration:   time.Since(started),
+			Err:        err,
+		})
+		if final {
+			return err
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// observe notifies Config.RequestObserver of an attempt of a request.
+func (c *Client) observe(event RequestEvent) {
+	if c.config.RequestObserver != nil {
+		c.config.RequestObserver(event)
+	}
+}
+
+// isTransient tells if a request failed with err may succeed if it is sent again.
+func (c *Client) isTransient(ctx context.Context, err error) bool {
+	internalError, ok := err.(*Error)
+	if !ok || ctx.Err() != nil {
+		return false
+	}
+	switch internalError.ErrCode {
+	case ErrCodeRequestExecution:
+		return true
+	case ErrCodeResponseReadBody:
+		return errors.Is(err, ErrIncompleteResponse)
+	case ErrCodeResponseStatusCode:
+		return internalError.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// executeAttempt sends req once, it returns the status code of the response, zero if none has been received.
+func (c *Client) executeAttempt(ctx context.Context, req internalRequest) (int, error) {
+	internalError := newError(req)
+	if err := ctx.Err(); err != nil {
+		return 0, internalError.WithErrCode(ErrCodeRequestExecution, err)
+	}
 
 	response := fasthttp.AcquireResponse()
 	defer fasthttp.ReleaseResponse(response)
-	err := c.sendRequest(&req, internalError, response)
+	err := c.sendRequest(ctx, &req, internalError, response)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	internalError.StatusCode = response.StatusCode()
 
+	if err := decompressResponse(response, internalError); err != nil {
+		return internalError.StatusCode, err
+	}
+
+	if c.responseHeaders != nil {
+		headers := map[string]string{}
+		response.Header.VisitAll(func(key, value []byte) {
+			headers[string(key)] = string(value)
+		})
+		*c.responseHeaders = headers
+	}
+
 	err = c.handleStatusCode(&req, response, internalError)
 	if err != nil {
-		return err
+		return internalError.StatusCode, err
 	}
 
 	err = c.handleResponse(&req, response, internalError)
 	if err != nil {
-		return err
+		return internalError.StatusCode, err
 	}
-	return nil
+	return internalError.StatusCode, nil
 }
 
-func (c *Client) sendRequest(req *internalRequest, internalError *Error, response *fasthttp.Response) error {
+func (c *Client) sendRequest(ctx context.Context, req *internalRequest, internalError *Error,
+	response *fasthttp.Response) error {
 	var (
 		request *fasthttp.Request
 
@@ -185,9 +615,9 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 	)
 
 	// Setup URL
-	requestURL, err := url.Parse(c.config.Host + req.endpoint)
+	requestURL, err := url.Parse(c.host(req) + req.endpoint)
 	if err != nil {
-		return errors.Wrap(err, "unable to parse url")
+		return internalError.WithErrCode(ErrCodeURLParsing, err)
 	}
 
 	// Build query parameters
@@ -201,12 +631,46 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 	}
 
 	request = fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(request)
+	defer func() {
+		// request is handed over to do once sent
+		if request != nil {
+			fasthttp.ReleaseRequest(request)
+		}
+	}()
 
 	request.SetRequestURI(requestURL.String())
 	request.Header.SetMethod(req.method)
 
-	if req.withRequest != nil {
+	contentType := contentTypeJSON
+	if req.contentType != "" {
+		contentType = req.contentType
+	}
+
+	contentEncoding := req.contentEncoding
+	compress := c.config.CompressRequest && contentEncoding == "" && req.withRequest != nil
+
+	if req.withRequest != nil && (contentType != contentTypeJSON || req.contentEncoding != "") {
+		switch body := req.withRequest.(type) {
+		case []byte:
+			internalError.RequestToString = string(body)
+			request.SetBody(body)
+		case string:
+			internalError.RequestToString = body
+			request.SetBodyString(body)
+		case io.Reader:
+			// The reader belongs to the caller, so its Close method is hidden from fasthttp which closes
+			// body streams once sent.
+			internalError.RequestToString = "streamed request"
+			if compress {
+				request.SetBodyStream(newGzipReader(body), -1)
+			} else {
+				request.SetBodyStream(struct{ io.Reader }{body}, -1)
+			}
+		default:
+			return internalError.WithErrCode(ErrCodeMarshalRequest,
+				errors.Errorf("a %s body must be a []byte, a string or an io.Reader, got %T", contentType, body))
+		}
+	} else if req.withRequest != nil {
 
 		// A json request is mandatory, so the request interface{} need to be passed as a raw json body.
 		rawJSONRequest := req.withRequest
@@ -217,6 +681,9 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 		} else {
 			data, err = json.Marshal(rawJSONRequest)
 		}
+		if err == nil && c.config.CanonicalJSON {
+			data, err = canonicalJSON(data)
+		}
 		internalError.RequestToString = string(data)
 		if err != nil {
 			return internalError.WithErrCode(ErrCodeMarshalRequest, err)
@@ -224,16 +691,57 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 		request.SetBody(data)
 	}
 
-	// adding request headers
-	request.Header.Set("Content-Type", "application/json")
+	if compress {
+		if !request.IsBodyStream() {
+			request.SetBody(fasthttp.AppendGzipBytes(nil, request.Body()))
+		}
+		contentEncoding = "gzip"
+	}
+
+	// adding request headers, the custom ones first so that they can not replace the ones of the client
+	for key, value := range c.config.Headers {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("Content-Type", contentType)
+	if contentEncoding != "" {
+		request.Header.Set("Content-Encoding", contentEncoding)
+	}
+	if c.config.CompressRequest {
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
 	if c.config.APIKey != "" {
-		request.Header.Set("X-Meili-API-Key", c.config.APIKey)
+		apiKeyHeader := c.config.APIKeyHeader
+		if apiKeyHeader == "" {
+			apiKeyHeader = DefaultAPIKeyHeader
+		}
+		request.Header.Set(apiKeyHeader, c.config.APIKey)
 	}
 
-	// request is sent
-	err = c.httpClient.Do(request, response)
+	// request is sent, before the sooner of the ctx deadline and the configured timeout
+	deadline, hasDeadline := ctx.Deadline()
+	timeoutFirst := false
+	if c.config.Timeout > 0 {
+		if timeout := time.Now().Add(c.config.Timeout); !hasDeadline || timeout.Before(deadline) {
+			deadline, hasDeadline, timeoutFirst = timeout, true, true
+		}
+	}
+	sent := request
+	request = nil
+	err = c.do(ctx, deadline, sent, response)
 
 	// request execution fail
+	if err == fasthttp.ErrTimeout && hasDeadline && !timeoutFirst {
+		return internalError.WithErrCode(ErrCodeRequestExecution, context.DeadlineExceeded)
+	}
+	if err != nil && ctx.Err() != nil {
+		return internalError.WithErrCode(ErrCodeRequestExecution, ctx.Err())
+	}
+	if err == fasthttp.ErrBodyTooLarge {
+		return internalError.WithErrCode(ErrCodeResponseBodyTooLarge, err)
+	}
+	if err == io.ErrUnexpectedEOF {
+		return internalError.WithErrCode(ErrCodeResponseReadBody, errors.Wrap(ErrIncompleteResponse, err.Error()))
+	}
 	if err != nil {
 		return internalError.WithErrCode(ErrCodeRequestExecution, err)
 	}
@@ -241,6 +749,79 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 	return nil
 }
 
+// canonicalJSON re-encodes data with sorted object keys. Numbers are kept as they were written.
+func canonicalJSON(data []byte) ([]byte, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// host returns the host req is sent to, according to Config.ReadHost and Config.WriteHost.
+func (c *Client) host(req *internalRequest) string {
+	if req.host != "" {
+		return req.host
+	}
+	host := c.config.WriteHost
+	if c.readsReplica(req) {
+		host = c.config.ReadHost
+	}
+	if host == "" {
+		return c.config.Host
+	}
+	return host
+}
+
+// do sends request within ctx and before deadline, if not zero, and releases it. fasthttp cannot abort a request
+// in flight, so when ctx can be done the request is sent in a goroutine, left to finish in the background once
+// ctx is done.
+func (c *Client) do(ctx context.Context, deadline time.Time, request *fasthttp.Request,
+	response *fasthttp.Response) error {
+
+	send := func(response *fasthttp.Response) error {
+		if deadline.IsZero() {
+			return c.httpClient.Do(request, response)
+		}
+		return c.httpClient.DoDeadline(request, response, deadline)
+	}
+
+	if ctx.Done() == nil {
+		defer fasthttp.ReleaseRequest(request)
+		return send(response)
+	}
+
+	pending := fasthttp.AcquireResponse()
+	done := make(chan error, 1)
+	go func() {
+		done <- send(pending)
+	}()
+
+	select {
+	case err := <-done:
+		pending.CopyTo(response)
+		fasthttp.ReleaseResponse(pending)
+		fasthttp.ReleaseRequest(request)
+		return err
+	case <-ctx.Done():
+		go func() {
+			<-done
+			fasthttp.ReleaseResponse(pending)
+			fasthttp.ReleaseRequest(request)
+		}()
+		return ctx.Err()
+	}
+}
+
 func (c *Client) handleStatusCode(req *internalRequest, response *fasthttp.Response, internalError *Error) error {
 	if req.acceptedStatusCodes != nil {
 
@@ -254,6 +835,10 @@ func (c *Client) handleStatusCode(req *internalRequest, response *fasthttp.Respo
 		// At this point the response status code is a failure.
 		rawBody := response.Body()
 
+		// A custom fasthttp.Client may not enforce the limit itself.
+		if c.config.MaxResponseBodySize > 0 && len(rawBody) > c.config.MaxResponseBodySize {
+			return internalError.WithErrCode(ErrCodeResponseBodyTooLarge, fasthttp.ErrBodyTooLarge)
+		}
 		internalError.ErrorBody(rawBody)
 
 		return internalError.WithErrCode(ErrCodeResponseStatusCode)
@@ -262,11 +847,42 @@ func (c *Client) handleStatusCode(req *internalRequest, response *fasthttp.Respo
 	return nil
 }
 
+// decompressResponse replaces the body of a gzip encoded response by the decompressed one.
+func decompressResponse(response *fasthttp.Response, internalError *Error) error {
+	if !bytes.EqualFold(response.Header.Peek("Content-Encoding"), []byte("gzip")) {
+		return nil
+	}
+
+	body, err := response.BodyGunzip()
+	if err == io.ErrUnexpectedEOF {
+		return internalError.WithErrCode(ErrCodeResponseReadBody, errors.Wrap(ErrIncompleteResponse, err.Error()))
+	}
+	if err != nil {
+		return internalError.WithErrCode(ErrCodeResponseReadBody, err)
+	}
+	response.Header.Del("Content-Encoding")
+	response.SetBody(body)
+	response.Header.SetContentLength(len(body))
+	return nil
+}
+
 func (c *Client) handleResponse(req *internalRequest, response *fasthttp.Response, internalError *Error) (err error) {
 	if req.withResponse != nil {
 
 		// A json response is mandatory, so the response interface{} need to be unmarshal from the response payload.
 		rawBody := response.Body()
+
+		// A custom fasthttp.Client may not check that the whole body has been received.
+		if contentLength := response.Header.ContentLength(); contentLength > 0 && len(rawBody) < contentLength {
+			internalError.ResponseToString = string(rawBody)
+			return internalError.WithErrCode(ErrCodeResponseReadBody, errors.Wrapf(ErrIncompleteResponse,
+				"received %d bytes of %d", len(rawBody), contentLength))
+		}
+
+		// A custom fasthttp.Client may not enforce the limit itself.
+		if c.config.MaxResponseBodySize > 0 && len(rawBody) > c.config.MaxResponseBodySize {
+			return internalError.WithErrCode(ErrCodeResponseBodyTooLarge, fasthttp.ErrBodyTooLarge)
+		}
 		internalError.ResponseToString = string(rawBody)
 
 		var err error
@@ -289,31 +905,695 @@ func (c Client) DefaultWaitForPendingUpdate(indexUID string, updateID *AsyncUpda
 	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancelFunc()
 
-	return c.WaitForPendingUpdate(ctx, time.Millisecond*50, indexUID, updateID)
+	return c.WaitForPendingUpdate(ctx, defaultPollInterval, indexUID, updateID)
 }
 
 // WaitForPendingUpdate waits for the end of an update.
 // The function will check by regular interval provided in parameter interval
-// the UpdateStatus. If it is not UpdateStatusEnqueued or the ctx cancelled
-// we return the UpdateStatus.
+// the UpdateStatus. If it is neither UpdateStatusEnqueued nor UpdateStatusProcessing or the ctx cancelled
+// we return the UpdateStatus. If the index does not exist anymore, an error matching ErrIndexUnavailable is
+// returned. A task, see AsyncUpdateID.IsTask, is checked at /tasks/:uid whatever indexID.
 func (c Client) WaitForPendingUpdate(
 	ctx context.Context,
 	interval time.Duration,
 	indexID string,
 	updateID *AsyncUpdateID) (UpdateStatus, error) {
 
-	apiUpdates := c.Updates(indexID)
 	for {
 		if err := ctx.Err(); err != nil {
 			return "", err
 		}
-		update, err := apiUpdates.Get(updateID.UpdateID)
+		update, err := c.getUpdate(indexID, updateID)
+		if errors.Is(err, ErrIndexUnavailable) {
+			return UpdateStatusUnknown, err
+		}
 		if err != nil {
 			return UpdateStatusUnknown, nil
 		}
-		if update.Status != UpdateStatusEnqueued {
+		if !update.Status.isPending() {
 			return update.Status, nil
 		}
-		time.Sleep(interval)
+
+		select {
+		case <-time.After(c.pollInterval(interval)):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// pollInterval returns interval randomized according to Config.PollJitter.
+func (c Client) pollInterval(interval time.Duration) time.Duration {
+	return jitter(interval, c.config.PollJitter)
+}
+
+// jitter randomizes duration by up to ±fraction of it, fraction being capped to 1.
+func jitter(duration time.Duration, fraction float64) time.Duration {
+	fraction = math.Min(fraction, 1)
+	if fraction <= 0 {
+		return duration
+	}
+	return time.Duration(float64(duration) * (1 + fraction*(2*rand.Float64()-1)))
+}
+
+// WaitForDump waits for the end of the creation of a dump, checking its status every interval, and returns its
+// final status. If ctx is done first, ctx.Err() is returned.
+func (c Client) WaitForDump(ctx context.Context, interval time.Duration, uid string) (DumpStatus, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		dump, err := c.Dumps().GetStatus(uid)
+		if err != nil {
+			return "", err
+		}
+		if dump.Status != DumpStatusInProgress {
+			return dump.Status, nil
+		}
+
+		select {
+		case <-time.After(c.pollInterval(interval)):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
 	}
 }
+
+// WaitForPendingUpdateAsync runs WaitForPendingUpdate in a goroutine, for code not using contexts. The result is
+// sent on the returned channel, which is then closed. Calling the returned function stops the wait, the channel
+// is then closed without result.
+func (c Client) WaitForPendingUpdateAsync(interval time.Duration, indexID string,
+	updateID *AsyncUpdateID) (<-chan WaitResult, context.CancelFunc) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := make(chan WaitResult, 1)
+
+	go func() {
+		defer close(results)
+		status, err := c.WaitForPendingUpdate(ctx, interval, indexID, updateID)
+		if ctx.Err() != nil {
+			return
+		}
+		results <- WaitResult{Status: status, Err: err}
+	}()
+
+	return results, cancel
+}
+
+// WaitForAll waits concurrently for the end of all the updates, e.g. the batches sent by AddFromChannel, and
+// reports the outcome of each of them: a failed batch does not hide the others. At most concurrency updates are
+// polled at the same time, the others being queued, a concurrency of zero or less polls them all at once.
+// If ctx is done first, ctx.Err() is returned along with the result, the updates still pending or queued having
+// ctx.Err() as Error.
+func (c Client) WaitForAll(ctx context.Context, indexID string, updateIDs []*AsyncUpdateID,
+	concurrency int) (*BatchResult, error) {
+
+	result := &BatchResult{Outcomes: make([]BatchOutcome, len(updateIDs))}
+
+	workers := concurrency
+	if workers <= 0 || len(updateIDs) < workers {
+		workers = len(updateIDs)
+	}
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					result.Outcomes[i] = BatchOutcome{UpdateID: updateIDs[i].UpdateID, Status: UpdateStatusUnknown,
+						Error: err.Error()}
+					continue
+				}
+				result.Outcomes[i] = c.waitForOutcome(ctx, indexID, updateIDs[i])
+			}
+		}()
+	}
+	for i := range updateIDs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, outcome := range result.Outcomes {
+		if outcome.Succeeded() {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result, ctx.Err()
+}
+
+func (c Client) waitForOutcome(ctx context.Context, indexID string, updateID *AsyncUpdateID) BatchOutcome {
+	outcome := BatchOutcome{UpdateID: updateID.UpdateID, Status: UpdateStatusUnknown}
+	for {
+		update, err := c.getUpdate(indexID, updateID)
+		if err != nil {
+			outcome.Error = err.Error()
+			return outcome
+		}
+		outcome.Status = update.Status
+		if !update.Status.isPending() {
+			outcome.Error = update.Error
+			return outcome
+		}
+
+		select {
+		case <-time.After(c.pollInterval(defaultPollInterval)):
+		case <-ctx.Done():
+			outcome.Error = ctx.Err().Error()
+			return outcome
+		}
+	}
+}
+
+// WaitForSearchable waits for a document to be indexed, e.g. to read it after adding it without knowing the
+// update id. The document is fetched every interval until it is found or the ctx is done, in which case
+// ctx.Err() is returned. Any other error than the document not being found, including the index not being
+// found, is returned right away.
+func (c Client) WaitForSearchable(ctx context.Context, indexID, docID string, interval time.Duration) error {
+	apiDocuments := c.Documents(indexID)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var document RawType
+		err := apiDocuments.Get(docID, &document)
+		if err == nil {
+			return nil
+		}
+		if e, ok := err.(*Error); !ok || e.StatusCode != http.StatusNotFound || errors.Is(err, ErrIndexUnavailable) {
+			return err
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ForceReindex re-applies the current settings of an index and waits for the resulting update.
+// Sending the settings again makes MeiliSearch rebuild the whole index, which can be used to recover an index
+// in a bad state. Beware that it is as costly as indexing all the documents again.
+func (c Client) ForceReindex(ctx context.Context, indexID string) (*Update, error) {
+	apiSettings := c.onPrimary().Settings(indexID)
+
+	settings, err := apiSettings.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	updateID, err := apiSettings.UpdateAll(*settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.WaitForPendingUpdate(ctx, defaultPollInterval, indexID, updateID); err != nil {
+		return nil, err
+	}
+
+	return c.getUpdate(indexID, updateID)
+}
+
+// CopySettings replaces the settings of the dstUID index by the ones of the srcUID index, e.g. to create a
+// staging copy of an index. Both indexes must exist, otherwise an error matching ErrIndexUnavailable is returned.
+// The source settings are applied in a single update, whose update id is returned. Each setting is sent, the empty
+// ones included, so that no destination setting is left over.
+func (c Client) CopySettings(srcUID, dstUID string) (*AsyncUpdateID, error) {
+	if _, err := c.Indexes().Get(dstUID); err != nil {
+		return nil, err
+	}
+
+	settings, err := newClientSettings(c.onPrimary(), srcUID).getAllRaw("CopySettings")
+	if err != nil {
+		return nil, err
+	}
+
+	return newClientSettings(&c, dstUID).updateAllRaw(settings, "CopySettings")
+}
+
+// CreateIndexWithUpdate creates an index and gets the task creating it, read from /tasks/:uid, whose progress can
+// then be followed with WaitForPendingUpdate. The update is nil when the index has been created synchronously,
+// as by MeiliSearch versions not returning a task.
+func (c Client) CreateIndexWithUpdate(request CreateIndexRequest) (*CreateIndexResponse, *Update, error) {
+	resp, err := c.Indexes().Create(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updateID := resp.AsyncUpdateID()
+	if updateID == nil {
+		return resp, nil, nil
+	}
+	update, err := c.getUpdate(resp.UID, updateID)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, update, nil
+}
+
+// ExportRelevanceConfig exports the ranking rules, synonyms, stop words and searchable attributes of an index as
+// a versioned RelevanceConfig json, e.g. to move them from staging to production with ImportRelevanceConfig.
+func (c Client) ExportRelevanceConfig(indexID string) ([]byte, error) {
+	settings, err := c.Settings(indexID).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	config := RelevanceConfig{
+		Version:              RelevanceConfigVersion,
+		RankingRules:         settings.RankingRules,
+		Synonyms:             settings.Synonyms,
+		StopWords:            settings.StopWords,
+		SearchableAttributes: settings.SearchableAttributes,
+	}
+	if config.RankingRules == nil {
+		config.RankingRules = []string{}
+	}
+	if config.Synonyms == nil {
+		config.Synonyms = map[string][]string{}
+	}
+	if config.StopWords == nil {
+		config.StopWords = []string{}
+	}
+	if config.SearchableAttributes == nil {
+		config.SearchableAttributes = []string{"*"}
+	}
+	return json.Marshal(config)
+}
+
+// ApplySettingsFromReader reads a settings JSON document from r, e.g. a file kept in version control, and applies
+// it to an index with APISettings.UpdateAll. The document is rejected without being sent if it has keys which are
+// not settings, the error naming them, so that a typo is not silently ignored.
+func (c Client) ApplySettingsFromReader(indexID string, r io.Reader) (*AsyncUpdateID, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the settings")
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, errors.Wrap(err, "unable to read the settings")
+	}
+	known := map[string]bool{}
+	settingsType := reflect.TypeOf(Settings{})
+	for i := 0; i < settingsType.NumField(); i++ {
+		known[strings.Split(settingsType.Field(i).Tag.Get("json"), ",")[0]] = true
+	}
+	var unknown []string
+	for key := range keys {
+		if !known[key] {
+			unknown = append(unknown, strconv.Quote(key))
+		}
+	}
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return nil, errors.Errorf("unknown settings %s", strings.Join(unknown, ", "))
+	}
+
+	var settings Settings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, errors.Wrap(err, "unable to read the settings")
+	}
+	return c.Settings(indexID).UpdateAll(settings)
+}
+
+// ImportRelevanceConfig applies a RelevanceConfig exported by ExportRelevanceConfig to an index, replacing its
+// ranking rules, synonyms, stop words and searchable attributes. The other settings are left unchanged.
+// Each setting is updated separately, the updates are returned in the order above. If one of them fails to be
+// sent, the updates already sent are returned along with the error.
+func (c Client) ImportRelevanceConfig(indexID string, data []byte) ([]*AsyncUpdateID, error) {
+	var config RelevanceConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, errors.Wrap(err, "unable to read the relevance config")
+	}
+	if config.Version != RelevanceConfigVersion {
+		return nil, errors.Errorf("unsupported relevance config version %d, expected %d",
+			config.Version, RelevanceConfigVersion)
+	}
+
+	settings := c.Settings(indexID)
+	updates := []func() (*AsyncUpdateID, error){
+		func() (*AsyncUpdateID, error) { return settings.UpdateRankingRules(config.RankingRules) },
+		func() (*AsyncUpdateID, error) { return settings.UpdateSynonyms(config.Synonyms) },
+		func() (*AsyncUpdateID, error) { return settings.UpdateStopWords(config.StopWords) },
+		func() (*AsyncUpdateID, error) {
+			return settings.UpdateSearchableAttributes(config.SearchableAttributes)
+		},
+	}
+
+	updateIDs := make([]*AsyncUpdateID, 0, len(updates))
+	for _, update := range updates {
+		updateID, err := update()
+		if err != nil {
+			return updateIDs, err
+		}
+		updateIDs = append(updateIDs, updateID)
+	}
+	return updateIDs, nil
+}
+
+// DetectNewFields returns the fields of the documents of an index, as reported by its stats, which are missing
+// from its displayed attributes or from its searchable attributes, sorted by name. It allows noticing documents
+// with new fields that would not be returned or searched.
+func (c Client) DetectNewFields(indexID string) ([]string, error) {
+	stats, err := c.Stats().Get(indexID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := c.Settings(indexID).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := stats.FieldDistribution
+	if len(fields) == 0 {
+		fields = stats.FieldsFrequency
+	}
+
+	newFields := []string{}
+	for field := range fields {
+		if !coversField(settings.DisplayedAttributes, field) || !coversField(settings.SearchableAttributes, field) {
+			newFields = append(newFields, field)
+		}
+	}
+	sort.Strings(newFields)
+	return newFields, nil
+}
+
+// coversField tells if field is one of attributes, or a nested field of one of them. An empty list of attributes
+// or "*" means all the fields.
+func coversField(attributes []string, field string) bool {
+	if len(attributes) == 0 {
+		return true
+	}
+	for _, attribute := range attributes {
+		if attribute == "*" || attribute == field || strings.HasPrefix(field, attribute+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// listModifiedSincePageSize is the number of documents fetched per search by ListModifiedSince
+const listModifiedSincePageSize = 1000
+
+// ListModifiedSince fetches the documents whose field is after since, oldest first, e.g. to synchronize another
+// store incrementally. field must hold a unix timestamp in seconds and be filterable and sortable. The documents
+// are decoded into out, a pointer to a slice, as with SearchResponse.DecodeHits.
+// When the documents are more than the maxTotalHits pagination setting of the index, only this number of
+// documents is decoded and ErrPaginationLimitReached is returned. Likewise, if ctx is done first, the documents
+// already fetched are decoded and ctx.Err() is returned.
+func (c Client) ListModifiedSince(ctx context.Context, indexID string, field string, since time.Time,
+	out interface{}) error {
+
+	client := c.WithContext(ctx).(*Client)
+	request := SearchRequest{
+		Filter: field + " > " + strconv.FormatInt(since.Unix(), 10),
+		Sort:   []string{field + ":asc"},
+		Limit:  listModifiedSincePageSize,
+	}
+
+	settings, err := client.cachedSettings(indexID)
+	if err != nil {
+		return err
+	}
+	var maxTotalHits int64
+	if settings.Pagination != nil {
+		maxTotalHits = settings.Pagination.MaxTotalHits
+	}
+
+	// the hits are kept as received, so that they are decoded once into out without losing precision
+	hits := []json.RawMessage{}
+	partial := func(err error) error {
+		if decodeErr := decodeRawHits(hits, out); decodeErr != nil {
+			return decodeErr
+		}
+		return err
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return partial(err)
+		}
+		if maxTotalHits > 0 && request.Offset+request.Limit > maxTotalHits {
+			if request.Offset >= maxTotalHits {
+				return partial(ErrPaginationLimitReached)
+			}
+			request.Limit = maxTotalHits - request.Offset
+		}
+
+		resp, err := client.Search(indexID).Search(request)
+		if ctxErr := contextError(ctx, err); ctxErr != nil {
+			return partial(ctxErr)
+		}
+		if err != nil {
+			return err
+		}
+		var page []json.RawMessage
+		if err := resp.DecodeHits(&page); err != nil {
+			return err
+		}
+		hits = append(hits, page...)
+		if int64(len(page)) < request.Limit {
+			break
+		}
+		request.Offset += request.Limit
+	}
+
+	return decodeRawHits(hits, out)
+}
+
+// contextError returns ctx.Err() if err is the abort of a request sent within ctx, nil otherwise. The request may be
+// aborted at the deadline of ctx slightly before ctx is done, which is waited for.
+func contextError(ctx context.Context, err error) error {
+	if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// decodeRawHits decodes hits into out as SearchResponse.DecodeHits does.
+func decodeRawHits(hits []json.RawMessage, out interface{}) error {
+	data, err := json.Marshal(hits)
+	if err != nil {
+		return err
+	}
+	return (&SearchResponse{rawHits: data}).DecodeHits(out)
+}
+
+// dumpDocumentsPageSize is the number of documents fetched per request by DumpDocumentsToFile
+const dumpDocumentsPageSize = 1000
+
+// DumpDocumentsToFile writes all the documents of an index to the file at path as NDJSON, one document per line,
+// and returns the number of documents written. The file is created or truncated, it is removed if the dump
+// fails, e.g. because ctx is done, and 0 is returned.
+func (c Client) DumpDocumentsToFile(ctx context.Context, indexID, path string) (written int64, err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+			written = 0
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+	var line bytes.Buffer
+	request := ListDocumentsRequest{Limit: dumpDocumentsPageSize}
+	err = c.WithContext(ctx).Documents(indexID).EachDocument(request, func(document []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line.Reset()
+		if err := json.Compact(&line, document); err != nil {
+			return err
+		}
+		line.WriteByte('\n')
+		if _, err := writer.Write(line.Bytes()); err != nil {
+			return err
+		}
+		written++
+		return nil
+	})
+	if err != nil {
+		return written, err
+	}
+	return written, writer.Flush()
+}
+
+// PendingTaskCount returns the number of updates of an index which are enqueued or processing.
+func (c Client) PendingTaskCount(indexID string) (int, error) {
+	return c.pendingTaskCount([]string{indexID})
+}
+
+// TotalPendingTaskCount returns the number of updates of all indexes which are enqueued or processing.
+func (c Client) TotalPendingTaskCount() (int, error) {
+	return c.pendingTaskCount(nil)
+}
+
+// pendingTaskCount counts the pending updates of indexUIDs, or of all indexes if nil. The filtered /tasks
+// endpoint is used when the server has it, otherwise the updates of each index are listed.
+func (c Client) pendingTaskCount(indexUIDs []string) (int, error) {
+	queryParams := map[string]string{
+		"statuses": string(UpdateStatusEnqueued) + "," + string(UpdateStatusProcessing),
+		"limit":    "0",
+	}
+	if indexUIDs != nil {
+		queryParams["indexUids"] = strings.Join(indexUIDs, ",")
+	}
+
+	resp := &struct {
+		Total int `json:"total"`
+	}{}
+	req := internalRequest{
+		endpoint:            "/tasks",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		withQueryParams:     queryParams,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "PendingTaskCount",
+		apiName:             "Tasks",
+	}
+
+	err := c.executeRequest(req)
+	if err == nil {
+		return resp.Total, nil
+	}
+	if e, ok := err.(*Error); !ok || e.StatusCode != http.StatusNotFound {
+		return 0, err
+	}
+
+	if indexUIDs == nil {
+		indexes, err := c.Indexes().List()
+		if err != nil {
+			return 0, err
+		}
+		for _, index := range indexes {
+			indexUIDs = append(indexUIDs, index.UID)
+		}
+	}
+
+	count := 0
+	for _, indexUID := range indexUIDs {
+		updates, err := c.Updates(indexUID).List()
+		if err != nil {
+			return 0, err
+		}
+		for _, update := range updates {
+			if update.Status.isPending() {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// decodeDocument decodes a document received from MeiliSearch into dest, with the numbers as json.Number if
+// Config.UseJSONNumber is set.
+func (c Client) decodeDocument(data []byte, dest interface{}) error {
+	if !c.config.UseJSONNumber {
+		return json.Unmarshal(data, dest)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(dest)
+}
+
+// cachedSettings returns a copy of the settings of an index, fetching them only if they are not in the cache yet
+// or are older than Config.SettingsCacheTTL. While an update sent through the client is changing them, they are
+// fetched each time.
+func (c Client) cachedSettings(indexUID string) (*Settings, error) {
+	cached, pending, version := c.settingsCache.get(indexUID)
+	if cached != nil {
+		return cached, nil
+	}
+
+	cache := true
+	if pending != nil {
+		// the status is checked first, so that the settings fetched next are at least as recent
+		update, err := c.getUpdate(indexUID, pending)
+		cache = err == nil && !update.Status.isPending()
+		if cache {
+			c.settingsCache.settle(indexUID, pending, version)
+		}
+	}
+
+	settings, err := c.Settings(indexUID).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if !cache {
+		return settings, nil
+	}
+
+	ttl := c.config.SettingsCacheTTL
+	if ttl == 0 {
+		ttl = DefaultSettingsCacheTTL
+	}
+	c.settingsCache.set(indexUID, settings, version, ttl)
+	return settings, nil
+}
+
+// Diagnostics checks in one call that the server is healthy, tells its version and that the APIKey is allowed
+// to list the indexes. A failed check does not stop the others, the report tells which ones failed and why.
+// The checks are sent within ctx. An error is only returned when ctx is done before all checks ran, along with
+// the checks already done.
+func (c Client) Diagnostics(ctx context.Context) (*DiagnosticsReport, error) {
+	report := &DiagnosticsReport{OK: true}
+	client := c.WithContext(ctx)
+
+	checks := []struct {
+		name string
+		run  func() error
+	}{
+		{name: DiagnosticCheckHealth, run: func() error { return client.Health().Get() }},
+		{name: DiagnosticCheckVersion, run: func() error {
+			version, err := client.Version().Get()
+			if err == nil {
+				report.Version = version.PkgVersion
+			}
+			return err
+		}},
+		{name: DiagnosticCheckListIndexes, run: func() error {
+			_, err := client.Indexes().List()
+			return err
+		}},
+	}
+
+	for _, check := range checks {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		start := time.Now()
+		err := check.run()
+		result := DiagnosticCheck{Name: check.name, OK: err == nil, Latency: time.Since(start)}
+		if err != nil {
+			result.Error = err.Error()
+			if e, ok := err.(*Error); ok {
+				result.StatusCode = e.StatusCode
+			}
+			report.OK = false
+		}
+		report.Checks = append(report.Checks, result)
+
+		if ctxErr := contextError(ctx, err); ctxErr != nil {
+			return report, ctxErr
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,52 @@
+package meilisearch
+
+import (
+	"net/http"
+	"strconv"
+)
+
+type clientBatches struct {
+	client *Client
+}
+
+func newClientBatches(client *Client) clientBatches {
+	return clientBatches{client: client}
+}
+
+func (c clientBatches) Get(uid int64) (resp *Batch, err error) {
+	resp = &Batch{}
+	req := internalRequest{
+		endpoint:            "/batches/" + strconv.FormatInt(uid, 10),
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "Get",
+		apiName:             "Batches",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientBatches) List() (resp *BatchesResponse, err error) {
+	resp = &BatchesResponse{}
+	req := internalRequest{
+		endpoint:            "/batches",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "List",
+		apiName:             "Batches",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
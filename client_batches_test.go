@@ -0,0 +1,71 @@
+package meilisearch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const batchTestJSON = `{
+	"uid": 12,
+	"details": {"receivedDocuments": 2000, "indexedDocuments": 1990},
+	"stats": {
+		"totalNbTasks": 2,
+		"status": {"succeeded": 1, "failed": 1},
+		"types": {"documentAdditionOrUpdate": 2},
+		"indexUids": {"movies": 2}
+	},
+	"duration": "PT0.250518S",
+	"startedAt": "2024-11-05T16:02:14.138Z",
+	"finishedAt": "2024-11-05T16:02:14.388Z"
+}`
+
+func TestClientBatches_Get(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/batches/12" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(batchTestJSON))
+	})
+
+	batch, err := c.Batches().Get(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, int64(12), batch.UID)
+	assert.Equal(t, float64(2000), batch.Details["receivedDocuments"])
+	assert.Equal(t, BatchStats{
+		TotalNbTasks: 2,
+		Status:       map[string]int64{"succeeded": 1, "failed": 1},
+		Types:        map[string]int64{"documentAdditionOrUpdate": 2},
+		IndexUIDs:    map[string]int64{"movies": 2},
+	}, batch.Stats)
+	assert.Equal(t, "PT0.250518S", batch.Duration)
+	assert.False(t, batch.StartedAt.IsZero())
+	assert.NotNil(t, batch.FinishedAt)
+}
+
+func TestClientBatches_List(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/batches" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"results":[` + batchTestJSON + `],"total":13,"limit":20,"from":12,"next":null}`))
+	})
+
+	resp, err := c.Batches().List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, resp.Results, 1)
+	assert.Equal(t, int64(12), resp.Results[0].UID)
+	assert.Equal(t, int64(13), resp.Total)
+	assert.Equal(t, int64(20), resp.Limit)
+	assert.Equal(t, int64(12), *resp.From)
+	assert.Nil(t, resp.Next)
+}
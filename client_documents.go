@@ -1,11 +1,68 @@
 package meilisearch
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
+// DocumentsOption configures how documents are sent by AddOrReplace and AddOrUpdate.
+type DocumentsOption func(*documentsOptions)
+
+type documentsOptions struct {
+	primaryKey   string
+	contentType  string
+	csvDelimiter string
+	gzip         bool
+}
+
+// WithPrimaryKey sets the primary key used to index the documents.
+func WithPrimaryKey(primaryKey string) DocumentsOption {
+	return func(o *documentsOptions) {
+		o.primaryKey = primaryKey
+	}
+}
+
+// WithContentType sends the documents as already encoded in the given format instead of marshalling them to JSON.
+// The documents must then be a []byte, a string or an io.Reader.
+func WithContentType(contentType string) DocumentsOption {
+	return func(o *documentsOptions) {
+		o.contentType = contentType
+	}
+}
+
+// WithCSV sends the documents as CSV, see WithContentType.
+func WithCSV() DocumentsOption {
+	return WithContentType("text/csv")
+}
+
+// WithNDJSON sends the documents as NDJSON (one JSON object per line), see WithContentType.
+func WithNDJSON() DocumentsOption {
+	return WithContentType("application/x-ndjson")
+}
+
+// WithCSVDelimiter sets the delimiter of the CSV documents sent with WithCSV, it defaults to a comma.
+func WithCSVDelimiter(delimiter rune) DocumentsOption {
+	return func(o *documentsOptions) {
+		o.csvDelimiter = string(delimiter)
+	}
+}
+
+// WithGzip compresses the documents with gzip while they are sent, which speeds up big imports. The documents
+// must be already encoded, see WithContentType: an io.Reader is streamed without being buffered.
+func WithGzip() DocumentsOption {
+	return func(o *documentsOptions) {
+		o.gzip = true
+	}
+}
+
 type clientDocuments struct {
 	client   *Client
 	indexUID string
@@ -32,6 +89,30 @@ func (c clientDocuments) Get(identifier string, documentPtr interface{}) error {
 	return nil
 }
 
+func (c clientDocuments) GetInto(identifier string, dest interface{}) error {
+	var raw RawType
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/documents/" + identifier,
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        &raw,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetInto",
+		apiName:             "Documents",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return err
+	}
+
+	if err := c.client.decodeDocument(raw, dest); err != nil {
+		internalError := newError(req)
+		internalError.ResponseToString = string(raw)
+		return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
+	}
+	return nil
+}
+
 func (c clientDocuments) Delete(identifier string) (resp *AsyncUpdateID, err error) {
 	resp = &AsyncUpdateID{}
 	req := internalRequest{
@@ -91,6 +172,9 @@ func (c clientDocuments) List(request ListDocumentsRequest, response interface{}
 	if len(request.AttributesToRetrieve) != 0 {
 		req.withQueryParams["attributesToRetrieve"] = strings.Join(request.AttributesToRetrieve, ",")
 	}
+	if len(request.IDs) != 0 {
+		req.withQueryParams["ids"] = strings.Join(request.IDs, ",")
+	}
 
 	if err := c.client.executeRequest(req); err != nil {
 		return err
@@ -99,79 +183,132 @@ func (c clientDocuments) List(request ListDocumentsRequest, response interface{}
 	return nil
 }
 
-func (c clientDocuments) AddOrReplace(documentsPtr interface{}) (resp *AsyncUpdateID, err error) {
-	resp = &AsyncUpdateID{}
+func (c clientDocuments) Count() (int64, error) {
+	resp := &StatsIndex{}
 	req := internalRequest{
-		endpoint:            "/indexes/" + c.indexUID + "/documents",
-		method:              http.MethodPost,
-		withRequest:         documentsPtr,
+		endpoint:            "/indexes/" + c.indexUID + "/stats",
+		method:              http.MethodGet,
+		withRequest:         nil,
 		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "AddOrReplace",
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "Count",
 		apiName:             "Documents",
 	}
 
-	if err = c.client.executeRequest(req); err != nil {
-		return nil, err
+	if err := c.client.executeRequest(req); err != nil {
+		return 0, err
 	}
 
-	return resp, nil
+	return resp.NumberOfDocuments, nil
 }
 
-func (c clientDocuments) AddOrReplaceWithPrimaryKey(documentsPtr interface{}, primaryKey string) (resp *AsyncUpdateID, err error) {
-	resp = &AsyncUpdateID{}
-	req := internalRequest{
-		endpoint:            "/indexes/" + c.indexUID + "/documents?primaryKey=" + primaryKey,
-		method:              http.MethodPost,
-		withRequest:         documentsPtr,
-		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "AddOrReplaceWithPrimaryKey",
-		apiName:             "Documents",
+func (c clientDocuments) GetMany(identifiers []string, documentsPtr interface{}) error {
+	if len(identifiers) == 0 {
+		return json.Unmarshal([]byte("[]"), documentsPtr)
 	}
+	return c.List(ListDocumentsRequest{IDs: identifiers, Limit: int64(len(identifiers))}, documentsPtr)
+}
 
-	if err = c.client.executeRequest(req); err != nil {
-		return nil, err
+// eachDocumentPageSize is the number of documents fetched per request by EachDocument when the request has no limit
+const eachDocumentPageSize = 1000
+
+func (c clientDocuments) EachDocument(request ListDocumentsRequest, fn func(document []byte) error) error {
+	if request.Limit <= 0 {
+		request.Limit = eachDocumentPageSize
+	}
+	for {
+		var page []RawType
+		if err := c.List(request, &page); err != nil {
+			return err
+		}
+		for _, document := range page {
+			if err := fn(document); err != nil {
+				return err
+			}
+		}
+		if int64(len(page)) < request.Limit {
+			return nil
+		}
+		request.Offset += request.Limit
 	}
+}
 
-	return resp, nil
+func (c clientDocuments) AddOrReplace(documentsPtr interface{}, opts ...DocumentsOption) (resp *AsyncUpdateID, err error) {
+	return c.addDocuments(http.MethodPost, "AddOrReplace", documentsPtr, opts)
 }
 
-func (c clientDocuments) AddOrUpdate(documentsPtr interface{}) (*AsyncUpdateID, error) {
-	var err error
-	resp := &AsyncUpdateID{}
-	req := internalRequest{
-		endpoint:            "/indexes/" + c.indexUID + "/documents",
-		method:              http.MethodPut,
-		withRequest:         documentsPtr,
-		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "AddOrUpdate",
-		apiName:             "Documents",
-	}
+func (c clientDocuments) AddOrReplaceNDJSON(r io.Reader, opts ...DocumentsOption) (*AsyncUpdateID, error) {
+	return c.addDocuments(http.MethodPost, "AddOrReplaceNDJSON", r, append(opts, WithNDJSON()))
+}
 
-	if err = c.client.executeRequest(req); err != nil {
-		return nil, err
-	}
+func (c clientDocuments) AddOrReplaceCSV(r io.Reader, opts ...DocumentsOption) (*AsyncUpdateID, error) {
+	return c.addDocuments(http.MethodPost, "AddOrReplaceCSV", r, append(opts, WithCSV()))
+}
 
-	return resp, nil
+func (c clientDocuments) AddOrReplaceWithPrimaryKey(documentsPtr interface{}, primaryKey string) (resp *AsyncUpdateID, err error) {
+	return c.addDocuments(http.MethodPost, "AddOrReplaceWithPrimaryKey", documentsPtr, []DocumentsOption{WithPrimaryKey(primaryKey)})
+}
+
+func (c clientDocuments) AddOrUpdate(documentsPtr interface{}, opts ...DocumentsOption) (*AsyncUpdateID, error) {
+	return c.addDocuments(http.MethodPut, "AddOrUpdate", documentsPtr, opts)
+}
+
+func (c clientDocuments) AddOrUpdateCSV(r io.Reader, opts ...DocumentsOption) (*AsyncUpdateID, error) {
+	return c.addDocuments(http.MethodPut, "AddOrUpdateCSV", r, append(opts, WithCSV()))
 }
 
 func (c clientDocuments) AddOrUpdateWithPrimaryKey(documentsPtr interface{}, primaryKey string) (resp *AsyncUpdateID, err error) {
+	return c.addDocuments(http.MethodPut, "AddOrUpdateWithPrimaryKey", documentsPtr, []DocumentsOption{WithPrimaryKey(primaryKey)})
+}
+
+func (c clientDocuments) addDocuments(method string, functionName string, documentsPtr interface{}, opts []DocumentsOption) (resp *AsyncUpdateID, err error) {
+	options := documentsOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	resp = &AsyncUpdateID{}
 	req := internalRequest{
-		endpoint:            "/indexes/" + c.indexUID + "/documents?primaryKey=" + primaryKey,
-		method:              http.MethodPut,
+		endpoint:            "/indexes/" + c.indexUID + "/documents",
+		method:              method,
 		withRequest:         documentsPtr,
 		withResponse:        resp,
+		withQueryParams:     map[string]string{},
+		contentType:         options.contentType,
 		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "AddOrUpdateWithPrimaryKey",
+		functionName:        functionName,
 		apiName:             "Documents",
 	}
 
+	if options.primaryKey != "" {
+		req.withQueryParams["primaryKey"] = options.primaryKey
+	}
+	if options.csvDelimiter != "" {
+		req.withQueryParams["csvDelimiter"] = options.csvDelimiter
+	}
+	if options.gzip {
+		var body io.Reader
+		switch documents := documentsPtr.(type) {
+		case []byte:
+			body = bytes.NewReader(documents)
+		case string:
+			body = strings.NewReader(documents)
+		case io.Reader:
+			body = documents
+		}
+		if options.contentType == "" || body == nil {
+			return nil, newError(req).WithErrCode(ErrCodeRequestValidation,
+				errors.Errorf("gzip documents must be a []byte, a string or an io.Reader with a content type, got %T",
+					documentsPtr))
+		}
+		req.withRequest = newGzipReader(body)
+		req.contentEncoding = "gzip"
+	}
+
 	if err = c.client.executeRequest(req); err != nil {
 		return nil, err
 	}
+
 	return resp, nil
 }
 
@@ -201,3 +338,162 @@ func (c clientDocuments) IndexID() string {
 func (c clientDocuments) Client() ClientInterface {
 	return c.client
 }
+
+// AddFromChannel adds the documents received from in to the index of documents by batches of batchSize, each
+// batch being sent with AddOrReplace as soon as it is full. The last partial batch is sent when in is closed or
+// when ctx is done, in which case ctx.Err() is returned along with the update IDs of the batches sent.
+//
+// Like all the document methods, the documents are encoded with encoding/json: the field names and omitempty
+// options of the json tags of T are honored, there are no meilisearch specific tags.
+func AddFromChannel[T any](ctx context.Context, documents APIDocuments, in <-chan T, batchSize int) ([]*AsyncUpdateID, error) {
+	if batchSize <= 0 {
+		req := internalRequest{
+			endpoint:     "/indexes/" + documents.IndexID() + "/documents",
+			method:       http.MethodPost,
+			functionName: "AddFromChannel",
+			apiName:      "Documents",
+		}
+		return nil, newError(req).WithErrCode(ErrCodeRequestValidation, errors.Errorf("invalid batch size %d", batchSize))
+	}
+
+	var updateIDs []*AsyncUpdateID
+	batch := make([]T, 0, batchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		updateID, err := documents.AddOrReplace(batch)
+		if err != nil {
+			return err
+		}
+		updateIDs = append(updateIDs, updateID)
+		batch = make([]T, 0, batchSize)
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := flush(); err != nil {
+				return updateIDs, err
+			}
+			return updateIDs, ctx.Err()
+		case document, ok := <-in:
+			if !ok {
+				return updateIDs, flush()
+			}
+			batch = append(batch, document)
+			if len(batch) == batchSize {
+				if err := flush(); err != nil {
+					return updateIDs, err
+				}
+			}
+		}
+	}
+}
+
+// gzipReader compresses what it reads from src as it is read.
+type gzipReader struct {
+	src    io.Reader
+	chunk  []byte
+	buffer bytes.Buffer
+	writer *gzip.Writer
+	err    error
+}
+
+func newGzipReader(src io.Reader) *gzipReader {
+	r := &gzipReader{src: src, chunk: make([]byte, 32*1024)}
+	r.writer = gzip.NewWriter(&r.buffer)
+	return r
+}
+
+func (r *gzipReader) Read(p []byte) (int, error) {
+	for r.buffer.Len() == 0 && r.err == nil {
+		n, err := r.src.Read(r.chunk)
+		if n > 0 {
+			if _, err := r.writer.Write(r.chunk[:n]); err != nil {
+				return 0, err
+			}
+		}
+		switch {
+		case err == io.EOF:
+			if err := r.writer.Close(); err != nil {
+				return 0, err
+			}
+			r.err = io.EOF
+		case err != nil:
+			r.err = err
+		}
+	}
+
+	if r.buffer.Len() > 0 {
+		return r.buffer.Read(p)
+	}
+	return 0, r.err
+}
+
+// AddOrReplaceBatched adds the documents to the index of documents by batches of batchSize, each batch being sent
+// with AddOrReplace in its own request, so that a big slice does not become a request too big for MeiliSearch.
+// It stops at the first batch failing, whose error is returned along with the update IDs of the batches sent.
+func AddOrReplaceBatched[T any](documents APIDocuments, docs []T, batchSize int) ([]*AsyncUpdateID, error) {
+	if batchSize <= 0 {
+		req := internalRequest{
+			endpoint:     "/indexes/" + documents.IndexID() + "/documents",
+			method:       http.MethodPost,
+			functionName: "AddOrReplaceBatched",
+			apiName:      "Documents",
+		}
+		return nil, newError(req).WithErrCode(ErrCodeRequestValidation, errors.Errorf("invalid batch size %d", batchSize))
+	}
+
+	updateIDs := make([]*AsyncUpdateID, 0, (len(docs)+batchSize-1)/batchSize)
+	for start := 0; start < len(docs); start += batchSize {
+		end := start + batchSize
+		if end > len(docs) {
+			end = len(docs)
+		}
+		updateID, err := documents.AddOrReplace(docs[start:end])
+		if err != nil {
+			return updateIDs, err
+		}
+		updateIDs = append(updateIDs, updateID)
+	}
+	return updateIDs, nil
+}
+
+// GetDocument gets one document of the index of documents using its unique identifier and decodes it into a T, as
+// GetInto does.
+func GetDocument[T any](documents APIDocuments, identifier string) (*T, error) {
+	document := new(T)
+	if err := documents.GetInto(identifier, document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// ListDocuments lists the documents of the index of documents as List does and decodes them into a []T, with the
+// numbers as json.Number if Config.UseJSONNumber is set.
+func ListDocuments[T any](documents APIDocuments, request ListDocumentsRequest) ([]T, error) {
+	var raw RawType
+	if err := documents.List(request, &raw); err != nil {
+		return nil, err
+	}
+
+	decode := json.Unmarshal
+	if c, ok := documents.Client().(*Client); ok {
+		decode = c.decodeDocument
+	}
+	list := []T{}
+	if err := decode(raw, &list); err != nil {
+		internalError := newError(internalRequest{
+			endpoint:     "/indexes/" + documents.IndexID() + "/documents",
+			method:       http.MethodGet,
+			functionName: "ListDocuments",
+			apiName:      "Documents",
+		})
+		internalError.ResponseToString = string(raw)
+		return nil, internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
+	}
+	return list, nil
+}
@@ -1,7 +1,18 @@
 package meilisearch
 
 import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientDocuments_Get(t *testing.T) {
@@ -265,3 +276,610 @@ func TestClientDocuments_DeleteAllDocuments(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAddFromChannel(t *testing.T) {
+	var batches [][]docTest
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var batch []docTest
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Error(err)
+		}
+		batches = append(batches, batch)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":` + strconv.Itoa(len(batches)) + `}`))
+	})
+
+	in := make(chan docTest)
+	go func() {
+		for i := 0; i < 5; i++ {
+			in <- docTest{ID: strconv.Itoa(i), Name: "nestle"}
+		}
+		close(in)
+	}()
+
+	updateIDs, err := AddFromChannel(context.Background(), c.Documents("TestAddFromChannel"), in, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []*AsyncUpdateID{{UpdateID: 1}, {UpdateID: 2}, {UpdateID: 3}}, updateIDs)
+	assert.Len(t, batches, 3)
+	assert.Len(t, batches[0], 2)
+	assert.Len(t, batches[1], 2)
+	assert.Equal(t, []docTest{{ID: "4", Name: "nestle"}}, batches[2])
+}
+
+func TestAddFromChannel_Cancel(t *testing.T) {
+	var batches [][]docTest
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var batch []docTest
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Error(err)
+		}
+		batches = append(batches, batch)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan docTest)
+	go func() {
+		in <- docTest{ID: "123", Name: "nestle"}
+		cancel()
+	}()
+
+	updateIDs, err := AddFromChannel(ctx, c.Documents("TestAddFromChannel_Cancel"), in, 10)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	assert.Equal(t, []*AsyncUpdateID{{UpdateID: 1}}, updateIDs)
+	assert.Equal(t, [][]docTest{{{ID: "123", Name: "nestle"}}}, batches)
+}
+
+func TestClientDocuments_AddOptions(t *testing.T) {
+	type sentRequest struct {
+		method      string
+		query       string
+		contentType string
+		body        string
+	}
+	var sent sentRequest
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		sent = sentRequest{
+			method:      r.Method,
+			query:       r.URL.RawQuery,
+			contentType: r.Header.Get("Content-Type"),
+			body:        string(body),
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+	documents := c.Documents("TestClientDocuments_AddOptions")
+	books := []docTestBooks{{BookID: 123, Title: "Pride and Prejudice", Tag: "Nice book"}}
+
+	tests := []struct {
+		name     string
+		add      func() (*AsyncUpdateID, error)
+		expected sentRequest
+	}{
+		{
+			name: "no option",
+			add:  func() (*AsyncUpdateID, error) { return documents.AddOrUpdate(books) },
+			expected: sentRequest{
+				method:      http.MethodPut,
+				contentType: "application/json",
+				body:        `[{"book_id":123,"title":"Pride and Prejudice","tag":"Nice book"}]`,
+			},
+		},
+		{
+			name: "primary key",
+			add:  func() (*AsyncUpdateID, error) { return documents.AddOrUpdate(books, WithPrimaryKey("book_id")) },
+			expected: sentRequest{
+				method:      http.MethodPut,
+				query:       "primaryKey=book_id",
+				contentType: "application/json",
+				body:        `[{"book_id":123,"title":"Pride and Prejudice","tag":"Nice book"}]`,
+			},
+		},
+		{
+			name: "deprecated primary key method",
+			add:  func() (*AsyncUpdateID, error) { return documents.AddOrReplaceWithPrimaryKey(books, "book_id") },
+			expected: sentRequest{
+				method:      http.MethodPost,
+				query:       "primaryKey=book_id",
+				contentType: "application/json",
+				body:        `[{"book_id":123,"title":"Pride and Prejudice","tag":"Nice book"}]`,
+			},
+		},
+		{
+			name: "csv with primary key and delimiter",
+			add: func() (*AsyncUpdateID, error) {
+				return documents.AddOrReplace("book_id;title\n123;Pride and Prejudice\n",
+					WithCSV(), WithPrimaryKey("book_id"), WithCSVDelimiter(';'))
+			},
+			expected: sentRequest{
+				method:      http.MethodPost,
+				query:       "csvDelimiter=%3B&primaryKey=book_id",
+				contentType: "text/csv",
+				body:        "book_id;title\n123;Pride and Prejudice\n",
+			},
+		},
+		{
+			name: "ndjson reader",
+			add: func() (*AsyncUpdateID, error) {
+				return documents.AddOrUpdate(strings.NewReader(`{"book_id":123}`+"\n"+`{"book_id":456}`+"\n"), WithNDJSON())
+			},
+			expected: sentRequest{
+				method:      http.MethodPut,
+				contentType: "application/x-ndjson",
+				body:        `{"book_id":123}` + "\n" + `{"book_id":456}` + "\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateID, err := tt.add()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, int64(1), updateID.UpdateID)
+			assert.Equal(t, tt.expected, sent)
+		})
+	}
+
+	if _, err := documents.AddOrReplace(books, WithCSV()); err == nil || err.(*Error).ErrCode != ErrCodeMarshalRequest {
+		t.Fatalf("structs can not be sent as CSV, got %v", err)
+	}
+}
+
+func TestClientDocuments_GetInto(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/indexes/movies/documents/123" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Document 404 not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"123","name":"nestle","year":1943}`))
+	})
+
+	var doc struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := c.Documents("movies").GetInto("123", &doc); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "123", doc.ID)
+	assert.Equal(t, "nestle", doc.Name)
+
+	m := map[string]interface{}{}
+	if err := c.Documents("movies").GetInto("123", &m); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]interface{}{"id": "123", "name": "nestle", "year": float64(1943)}, m)
+
+	err := c.Documents("movies").GetInto("404", &m)
+	if err == nil {
+		t.Fatal("a missing document should fail")
+	}
+	assert.Equal(t, http.StatusNotFound, err.(*Error).StatusCode)
+
+	var wrong struct {
+		Name int `json:"name"`
+	}
+	err = c.Documents("movies").GetInto("123", &wrong)
+	if err == nil {
+		t.Fatal("decoding into a wrong type should fail")
+	}
+	assert.Equal(t, ErrCodeResponseUnmarshalBody, err.(*Error).ErrCode)
+}
+
+func TestClientDocuments_JSONTags(t *testing.T) {
+	type movie struct {
+		ID       string   `json:"movie_id"`
+		Title    string   `json:"name"`
+		Overview string   `json:"overview,omitempty"`
+		Genres   []string `json:"genres,omitempty"`
+		Internal string   `json:"-"`
+		Year     int
+	}
+
+	var stored []map[string]interface{}
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var batch []map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+				t.Error(err)
+			}
+			stored = append(stored, batch...)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+			return
+		}
+		for _, doc := range stored {
+			if r.URL.Path == "/indexes/movies/documents/"+doc["movie_id"].(string) {
+				data, _ := json.Marshal(doc)
+				_, _ = w.Write(data)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	movies := []movie{
+		{ID: "1", Title: "Carol", Genres: []string{"romance"}, Internal: "secret", Year: 2015},
+		{ID: "2", Title: "Wonder Woman", Overview: "Amazon princess", Year: 2017},
+	}
+	in := make(chan movie, len(movies))
+	for _, m := range movies {
+		in <- m
+	}
+	close(in)
+
+	if _, err := AddFromChannel(context.Background(), c.Documents("movies"), in, 10); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []map[string]interface{}{
+		{"movie_id": "1", "name": "Carol", "genres": []interface{}{"romance"}, "Year": float64(2015)},
+		{"movie_id": "2", "name": "Wonder Woman", "overview": "Amazon princess", "Year": float64(2017)},
+	}, stored)
+
+	for _, expected := range movies {
+		var got movie
+		if err := c.Documents("movies").Get(expected.ID, &got); err != nil {
+			t.Fatal(err)
+		}
+		expected.Internal = ""
+		assert.Equal(t, expected, got)
+
+		got = movie{}
+		if err := c.Documents("movies").GetInto(expected.ID, &got); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, got)
+	}
+}
+
+func TestClientDocuments_WithGzip(t *testing.T) {
+	type sentRequest struct {
+		contentType     string
+		contentEncoding string
+		body            string
+	}
+	var sent sentRequest
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		sent = sentRequest{
+			contentType:     r.Header.Get("Content-Type"),
+			contentEncoding: r.Header.Get("Content-Encoding"),
+		}
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := ioutil.ReadAll(reader)
+		sent.body = string(body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+	documents := c.Documents("TestClientDocuments_WithGzip")
+
+	ndjson := strings.Repeat(`{"book_id":123,"title":"Pride and Prejudice"}`+"\n", 5000)
+	updateID, err := documents.AddOrReplace(strings.NewReader(ndjson), WithNDJSON(), WithGzip())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1), updateID.UpdateID)
+	assert.Equal(t, sentRequest{contentType: "application/x-ndjson", contentEncoding: "gzip", body: ndjson}, sent)
+
+	if _, err := documents.AddOrUpdate("book_id\n123\n", WithCSV(), WithGzip()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, sentRequest{contentType: "text/csv", contentEncoding: "gzip", body: "book_id\n123\n"}, sent)
+
+	sent = sentRequest{}
+	_, err = documents.AddOrReplace([]docTestBooks{{BookID: 123}}, WithGzip())
+	if err == nil || err.(*Error).ErrCode != ErrCodeRequestValidation {
+		t.Fatalf("structs should be rejected, got %v", err)
+	}
+	assert.Empty(t, sent, "an invalid request should not be sent")
+}
+
+func TestClientDocuments_GetMany(t *testing.T) {
+	stored := map[string]string{"1": "nestle", "2": "ferrero", "3": "lindt"}
+	var query string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		var docs []map[string]string
+		for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+			if name, ok := stored[id]; ok {
+				docs = append(docs, map[string]string{"id": id, "name": name})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(docs)
+	})
+
+	var docs []map[string]string
+	if err := c.Documents("movies").GetMany([]string{"3", "404", "1"}, &docs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, query, "ids=3%2C404%2C1")
+	assert.Contains(t, query, "limit=3")
+	assert.Equal(t, []map[string]string{{"id": "3", "name": "lindt"}, {"id": "1", "name": "nestle"}}, docs)
+
+	query = "unset"
+	docs = nil
+	if err := c.Documents("movies").GetMany(nil, &docs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "unset", query, "no identifiers should not send a request")
+	assert.Empty(t, docs)
+}
+
+func TestClientDocuments_Count(t *testing.T) {
+	var paths []string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		if r.URL.Path != "/indexes/movies/stats" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index missing not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"numberOfDocuments":19654,"isIndexing":false,"fieldsFrequency":{"title":19654}}`))
+	})
+
+	count, err := c.Documents("movies").Count()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(19654), count)
+	assert.Equal(t, []string{"GET /indexes/movies/stats"}, paths)
+
+	_, err = c.Documents("missing").Count()
+	if err == nil {
+		t.Fatal("counting the documents of a missing index should fail")
+	}
+	assert.Equal(t, http.StatusNotFound, err.(*Error).StatusCode)
+}
+
+func TestClientDocuments_EachDocument(t *testing.T) {
+	var queries []string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var documents []string
+		for i := offset; i < offset+limit && i < 5; i++ {
+			documents = append(documents, `{"id":`+strconv.Itoa(i)+`}`)
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(documents, ",") + "]"))
+	})
+
+	var ids []string
+	err := c.Documents("movies").EachDocument(ListDocumentsRequest{Limit: 2}, func(document []byte) error {
+		var doc struct{ ID int }
+		if err := json.Unmarshal(document, &doc); err != nil {
+			return err
+		}
+		ids = append(ids, strconv.Itoa(doc.ID))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+	assert.Equal(t, []string{"limit=2", "limit=2&offset=2", "limit=2&offset=4"}, queries)
+
+	queries = nil
+	var calls int
+	err = c.Documents("movies").EachDocument(ListDocumentsRequest{Offset: 1}, func(document []byte) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, []string{"limit=1000&offset=1"}, queries)
+
+	queries = nil
+	stop := errors.New("stop")
+	err = c.Documents("movies").EachDocument(ListDocumentsRequest{Limit: 2}, func(document []byte) error {
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Len(t, queries, 1, "the iteration should stop at the first error of the callback")
+}
+
+func TestClientDocuments_AddOrReplaceNDJSON(t *testing.T) {
+	var method, query, contentType, body string
+	var contentLength int64
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		method, query, contentType, body = r.Method, r.URL.RawQuery, r.Header.Get("Content-Type"), string(raw)
+		contentLength = r.ContentLength
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":7}`))
+	})
+
+	reader, writer := io.Pipe()
+	go func() {
+		for i := 1; i <= 3; i++ {
+			_, _ = writer.Write([]byte(`{"id":` + strconv.Itoa(i) + `,"title":"book ` + strconv.Itoa(i) + `"}` + "\n"))
+		}
+		_ = writer.Close()
+	}()
+
+	resp, err := c.Documents("books").AddOrReplaceNDJSON(reader, WithPrimaryKey("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(7), resp.UpdateID)
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "primaryKey=id", query)
+	assert.Equal(t, "application/x-ndjson", contentType)
+	assert.Equal(t, int64(-1), contentLength, "the documents should be streamed")
+	assert.Equal(t, "{\"id\":1,\"title\":\"book 1\"}\n{\"id\":2,\"title\":\"book 2\"}\n{\"id\":3,\"title\":\"book 3\"}\n", body)
+}
+
+func TestClientDocuments_GetIntoUseJSONNumber(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":9007199254740993,"price":12.5}`))
+	}
+
+	var lossy struct {
+		ID float64 `json:"id"`
+	}
+	var exact struct {
+		ID json.Number `json:"id"`
+	}
+	c := newMockClient(t, Config{}, handler)
+	if err := c.Documents("products").GetInto("1", &lossy); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "9007199254740992", strconv.FormatFloat(lossy.ID, 'f', -1, 64), "a float64 loses the precision")
+	if err := c.Documents("products").GetInto("1", &exact); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, json.Number("9007199254740993"), exact.ID)
+
+	m := map[string]interface{}{}
+	if err := c.Documents("products").GetInto("1", &m); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, float64(9007199254740992), m["id"])
+
+	c = newMockClient(t, Config{UseJSONNumber: true}, handler)
+	m = map[string]interface{}{}
+	if err := c.Documents("products").GetInto("1", &m); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]interface{}{"id": json.Number("9007199254740993"), "price": json.Number("12.5")}, m)
+	if err := c.Documents("products").GetInto("1", &lossy); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, float64(9007199254740992), lossy.ID, "a float64 field is still a float64")
+}
+
+func TestClientDocuments_AddCSV(t *testing.T) {
+	var method, query, contentType, body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		method, query, contentType, body = r.Method, r.URL.RawQuery, r.Header.Get("Content-Type"), string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":3}`))
+	})
+	csv := "sku,name,price\nA-1,Chocolate,2.5\nB-2,Candy,1\n"
+
+	resp, err := c.Documents("products").AddOrReplaceCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(3), resp.UpdateID)
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "", query)
+	assert.Equal(t, "text/csv", contentType)
+	assert.Equal(t, csv, body)
+
+	if _, err := c.Documents("products").AddOrUpdateCSV(strings.NewReader(csv), WithPrimaryKey("sku")); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "primaryKey=sku", query)
+	assert.Equal(t, "text/csv", contentType)
+	assert.Equal(t, csv, body)
+}
+
+func TestAddOrReplaceBatched(t *testing.T) {
+	var batches [][]docTest
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var batch []docTest
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			t.Error(err)
+		}
+		batches = append(batches, batch)
+		if len(batches) == 5 {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			_, _ = w.Write([]byte(`{"message":"payload too large"}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":` + strconv.Itoa(len(batches)) + `}`))
+	})
+
+	docs := make([]docTest, 7)
+	for i := range docs {
+		docs[i] = docTest{ID: strconv.Itoa(i), Name: "nestle"}
+	}
+
+	updateIDs, err := AddOrReplaceBatched(c.Documents("movies"), docs, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []*AsyncUpdateID{{UpdateID: 1}, {UpdateID: 2}, {UpdateID: 3}}, updateIDs)
+	assert.Equal(t, [][]docTest{docs[0:3], docs[3:6], docs[6:7]}, batches)
+
+	updateIDs, err = AddOrReplaceBatched(c.Documents("movies"), docs, 3)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusRequestEntityTooLarge, err.(*Error).StatusCode)
+	}
+	assert.Equal(t, []*AsyncUpdateID{{UpdateID: 4}}, updateIDs, "the batches sent before the failure are returned")
+	assert.Len(t, batches, 5, "no batch should be sent after the failure")
+
+	batches = nil
+	updateIDs, err = AddOrReplaceBatched(c.Documents("movies"), []json.Marshaler{json.RawMessage(`{"id":"1"}`)}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, updateIDs, 1)
+	assert.Equal(t, [][]docTest{{{ID: "1"}}}, batches)
+
+	_, err = AddOrReplaceBatched(c.Documents("movies"), docs, 0)
+	assert.Equal(t, ErrCodeRequestValidation, err.(*Error).ErrCode)
+}
+
+func TestGetDocument(t *testing.T) {
+	c := newMockClient(t, Config{UseJSONNumber: true}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/documents/123":
+			_, _ = w.Write([]byte(`{"id":"123","name":"nestle","extra":{"year":1943}}`))
+		case "/indexes/movies/documents":
+			_, _ = w.Write([]byte(`[{"id":"123","name":"nestle"},{"id":"456","name":"ferrero","extra":{"year":1946}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Document 404 not found"}`))
+		}
+	})
+
+	type movie struct {
+		ID    string                 `json:"id"`
+		Name  string                 `json:"name"`
+		Extra map[string]interface{} `json:"extra"`
+	}
+	document, err := GetDocument[movie](c.Documents("movies"), "123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &movie{ID: "123", Name: "nestle", Extra: map[string]interface{}{"year": json.Number("1943")}}, document)
+
+	_, err = GetDocument[movie](c.Documents("movies"), "404")
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*Error).StatusCode)
+	}
+
+	documents, err := ListDocuments[movie](c.Documents("movies"), ListDocumentsRequest{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []movie{
+		{ID: "123", Name: "nestle"},
+		{ID: "456", Name: "ferrero", Extra: map[string]interface{}{"year": json.Number("1946")}},
+	}, documents)
+
+	_, err = ListDocuments[int](c.Documents("movies"), ListDocumentsRequest{})
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeResponseUnmarshalBody, err.(*Error).ErrCode)
+	}
+}
@@ -0,0 +1,53 @@
+package meilisearch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientDumps_Create(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/dumps" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"uid":"20201101-110357260","status":"in_progress","startedAt":"2020-11-01T11:03:57.260Z"}`))
+	})
+
+	dump, err := c.Dumps().Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "20201101-110357260", dump.UID)
+	assert.Equal(t, DumpStatusInProgress, dump.Status)
+	assert.False(t, dump.StartedAt.IsZero())
+	assert.Nil(t, dump.FinishedAt)
+}
+
+func TestClientDumps_GetStatus(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/dumps/20201101-110357260/status" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Dump not found","errorCode":"dump_not_found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"uid":"20201101-110357260","status":"failed","error":"no space left on device",` +
+			`"startedAt":"2020-11-01T11:03:57.260Z","finishedAt":"2020-11-01T11:04:02.100Z"}`))
+	})
+
+	dump, err := c.Dumps().GetStatus("20201101-110357260")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DumpStatusFailed, dump.Status)
+	assert.Equal(t, "no space left on device", dump.Error)
+	assert.NotNil(t, dump.FinishedAt)
+
+	_, err = c.Dumps().GetStatus("unknown")
+	if assert.Error(t, err) {
+		assert.Equal(t, "dump_not_found", err.(*Error).MeilisearchCode)
+	}
+}
@@ -1,7 +1,13 @@
 package meilisearch
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 type clientIndexes struct {
@@ -56,7 +62,7 @@ func (c clientIndexes) Create(request CreateIndexRequest) (resp *CreateIndexResp
 		method:              http.MethodPost,
 		withRequest:         request,
 		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusCreated},
+		acceptedStatusCodes: []int{http.StatusCreated, http.StatusAccepted},
 		functionName:        "Create",
 		apiName:             "Indexes",
 	}
@@ -64,6 +70,9 @@ func (c clientIndexes) Create(request CreateIndexRequest) (resp *CreateIndexResp
 		return nil, err
 	}
 
+	if resp.UID == "" {
+		resp.UID = request.UID
+	}
 	return resp, nil
 }
 
@@ -111,15 +120,152 @@ func (c clientIndexes) Delete(uid string) (ok bool, err error) {
 		method:              http.MethodDelete,
 		withRequest:         nil,
 		withResponse:        nil,
-		acceptedStatusCodes: []int{http.StatusNoContent},
+		acceptedStatusCodes: []int{http.StatusNoContent, http.StatusAccepted},
 		functionName:        "Delete",
 		apiName:             "Indexes",
 	}
 
-	// err is not nil if status code is not 204 StatusNoContent
+	// err is not nil if status code is not 204 StatusNoContent, or 202 StatusAccepted for the versions deleting
+	// the index asynchronously
 	if err := c.client.executeRequest(req); err != nil {
 		return false, err
 	}
 
 	return true, nil
 }
+
+func (c clientIndexes) ChangedSince(uid string, since time.Time) (bool, error) {
+	index, err := c.Get(uid)
+	if err != nil {
+		return false, err
+	}
+
+	return index.UpdatedAt.After(since), nil
+}
+
+func (c clientIndexes) Swap(uid string, otherUID string) (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/swap-indexes",
+		method:              http.MethodPost,
+		withRequest:         []map[string][]string{{"indexes": {uid, otherUID}}},
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "Swap",
+		apiName:             "Indexes",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ReindexAtomic replaces all the documents and settings of the uid index without downtime: they are loaded in a
+// temporary index, which is then swapped with the uid index once ready, and the previous content is deleted.
+// The uid index must exist and the server must support swapping indexes, which came with the tasks API.
+// The temporary index has a unique uid, so that an index left by a previous run does not get in the way.
+// On failure the temporary index is deleted and the uid index is left untouched, if this cleanup fails too a
+// *RollbackError is returned. If the swap may still be processed, e.g. because ctx is done, the temporary index is
+// left as is, as it may already hold the previous content or still hold the new one.
+func ReindexAtomic[T any](ctx context.Context, client ClientInterface, uid string, documents []T,
+	settings Settings) error {
+
+	index, err := client.Indexes().Get(uid)
+	if err != nil {
+		return err
+	}
+
+	tmpUID := uid + "_reindex_" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	created, err := client.Indexes().Create(CreateIndexRequest{UID: tmpUID, PrimaryKey: index.PrimaryKey})
+	if err != nil {
+		return err
+	}
+
+	cleanup := func(err error) error {
+		if _, cleanupErr := client.Indexes().Delete(tmpUID); cleanupErr != nil {
+			return &RollbackError{Err: err, RollbackErr: cleanupErr}
+		}
+		return err
+	}
+
+	if err := loadReindex(ctx, client, tmpUID, created.AsyncUpdateID(), documents, settings); err != nil {
+		return cleanup(err)
+	}
+
+	updateID, err := client.Indexes().Swap(uid, tmpUID)
+	if e, ok := err.(*Error); ok && e.StatusCode != 0 {
+		// MeiliSearch rejected the swap
+		return cleanup(err)
+	}
+	if err == nil {
+		err = waitForSuccess(ctx, client, uid, updateID)
+		if _, failed := err.(*updateFailedError); failed {
+			return cleanup(err)
+		}
+	}
+	if err != nil {
+		return errors.Wrapf(err, "the swap of %s with %s may still be processed, %s is left", uid, tmpUID, tmpUID)
+	}
+
+	// the temporary index now holds the previous content
+	_, err = client.Indexes().Delete(tmpUID)
+	return err
+}
+
+// loadReindex waits for the creation of the tmpUID index, if asynchronous, and loads it.
+func loadReindex[T any](ctx context.Context, client ClientInterface, tmpUID string, created *AsyncUpdateID,
+	documents []T, settings Settings) error {
+
+	if created != nil {
+		if err := waitForSuccess(ctx, client, tmpUID, created); err != nil {
+			return err
+		}
+	}
+
+	updateID, err := client.Settings(tmpUID).UpdateAll(settings)
+	if err != nil {
+		return err
+	}
+	if err := waitForSuccess(ctx, client, tmpUID, updateID); err != nil {
+		return err
+	}
+
+	updateID, err = client.Documents(tmpUID).AddOrReplace(documents)
+	if err != nil {
+		return err
+	}
+	return waitForSuccess(ctx, client, tmpUID, updateID)
+}
+
+// updateFailedError is returned by waitForSuccess when the update, or the task, has been processed without success.
+type updateFailedError struct {
+	message string
+}
+
+func (e *updateFailedError) Error() string {
+	return e.message
+}
+
+// waitForSuccess waits for an update, or a task, and returns an error if it has not been processed successfully.
+func waitForSuccess(ctx context.Context, client ClientInterface, indexID string, updateID *AsyncUpdateID) error {
+	result, err := client.WaitForAll(ctx, indexID, []*AsyncUpdateID{updateID}, 1)
+	if err != nil {
+		return err
+	}
+	outcome := result.Outcomes[0]
+	if outcome.Succeeded() {
+		return nil
+	}
+
+	kind := "update"
+	if updateID.IsTask {
+		kind = "task"
+	}
+	message := fmt.Sprintf("%s %d ended with status %s", kind, updateID.UpdateID, outcome.Status)
+	if outcome.Error != "" {
+		message += ": " + outcome.Error
+	}
+	return &updateFailedError{message: message}
+}
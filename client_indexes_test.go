@@ -1,7 +1,18 @@
 package meilisearch
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientIndexes_Create(t *testing.T) {
@@ -20,6 +31,44 @@ func TestClientIndexes_Create(t *testing.T) {
 	}
 }
 
+func TestClientIndexes_Create_Response(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"name":"movies","uid":"movies","createdAt":"2020-11-23T10:27:39.000000001Z",` +
+			`"updatedAt":"2020-11-23T10:27:39.000000001Z","primaryKey":"movie_id"}`))
+	})
+
+	resp, err := c.Indexes().Create(CreateIndexRequest{UID: "movies", PrimaryKey: "movie_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, "movies", resp.UID)
+		assert.Equal(t, "movie_id", resp.PrimaryKey)
+		assert.Equal(t, time.Date(2020, 11, 23, 10, 27, 39, 1, time.UTC), resp.CreatedAt.UTC())
+		assert.Nil(t, resp.AsyncUpdateID())
+	}
+}
+
+func TestClientIndexes_Create_Task(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":12,"indexUid":"movies","status":"enqueued","type":"indexCreation",` +
+			`"enqueuedAt":"2021-08-12T10:00:00Z"}`))
+	})
+
+	resp, err := c.Indexes().Create(CreateIndexRequest{UID: "movies", PrimaryKey: "movie_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"uid":"movies","primaryKey":"movie_id"}`, body)
+	assert.Equal(t, "movies", resp.UID)
+	assert.Equal(t, &AsyncUpdateID{UpdateID: 12, IsTask: true}, resp.AsyncUpdateID())
+}
+
 func TestClientIndexes_Get(t *testing.T) {
 	var indexUID = "TestClientIndexes_Get"
 
@@ -123,3 +172,187 @@ func TestClientIndexes_UpdatePrimaryKey(t *testing.T) {
 		t.Fatal("name of the index should be TestClientIndexes_Update2, found ", update.Name)
 	}
 }
+
+func TestClientIndexes_ChangedSince(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"movies","uid":"movies","createdAt":"2020-10-01T10:00:00Z","updatedAt":"2020-11-01T10:00:00Z","primaryKey":"id"}`))
+	})
+
+	changed, err := c.Indexes().ChangedSince("movies", time.Date(2020, 10, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Fatal("index updated after the given time should be reported as changed")
+	}
+
+	changed, err = c.Indexes().ChangedSince("movies", time.Date(2020, 11, 1, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Fatal("index not updated after the given time should not be reported as changed")
+	}
+}
+
+// newReindexMockClient replays a MeiliSearch server with the tasks API: the writes are enqueued as tasks, which are
+// processing when first checked at /tasks/:uid and then succeeded, or failed for the ones of failedType. Like such
+// a server, it has no /indexes/:uid/updates endpoint.
+// newReindexMockClient replays the tasks API for ReindexAtomic. The tasks of failedType fail, the ones of
+// pendingType are never processed. The temporary index is named <tmp> in calls and bodies, its uid is sent to tmpUID.
+func newReindexMockClient(t *testing.T, failedType, pendingType string, calls *[]string, bodies map[string]string,
+	tmpUID *string) *Client {
+
+	var tasks []string
+	checked := map[int]bool{}
+	return newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPost && r.URL.Path == "/indexes" {
+			var index struct {
+				UID string `json:"uid"`
+			}
+			_ = json.Unmarshal(raw, &index)
+			*tmpUID = index.UID
+		}
+		call := r.Method + " " + r.URL.Path
+		if *tmpUID != "" {
+			call = strings.ReplaceAll(call, *tmpUID, "<tmp>")
+		}
+		*calls = append(*calls, call)
+		bodies[call] = string(raw)
+
+		enqueue := func(indexUID, taskType string) {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprintf(w, `{"taskUid":%d,"indexUid":%s,"status":"enqueued","type":"%s",`+
+				`"enqueuedAt":"2023-02-08T10:00:00.000000Z"}`, len(tasks), indexUID, taskType)
+			tasks = append(tasks, taskType)
+		}
+
+		switch {
+		case call == "GET /indexes/movies":
+			_, _ = w.Write([]byte(`{"uid":"movies","primaryKey":"movie_id","createdAt":"2023-02-01T10:00:00Z",` +
+				`"updatedAt":"2023-02-01T10:00:00Z"}`))
+		case call == "POST /indexes":
+			enqueue(`"`+*tmpUID+`"`, "indexCreation")
+		case call == "POST /indexes/<tmp>/settings":
+			enqueue(`"`+*tmpUID+`"`, "settingsUpdate")
+		case call == "POST /indexes/<tmp>/documents":
+			enqueue(`"`+*tmpUID+`"`, "documentAdditionOrUpdate")
+		case call == "POST /swap-indexes":
+			enqueue("null", "indexSwap")
+		case call == "DELETE /indexes/<tmp>":
+			enqueue(`"`+*tmpUID+`"`, "indexDeletion")
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/tasks/"):
+			uid, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/tasks/"))
+			if err != nil || uid >= len(tasks) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"Task not found.","code":"task_not_found","type":"invalid_request"}`))
+				return
+			}
+			status, taskError, finishedAt := "succeeded", "null", `"2023-02-08T10:00:01.000000Z"`
+			switch {
+			case !checked[uid] || tasks[uid] == pendingType:
+				checked[uid] = true
+				status, finishedAt = "processing", "null"
+			case tasks[uid] == failedType:
+				status = "failed"
+				taskError = `{"message":"Document identifier ` + "`\\\"1 2\\\"`" + ` is invalid.",` +
+					`"code":"invalid_document_id","type":"invalid_request"}`
+			}
+			_, _ = fmt.Fprintf(w, `{"uid":%d,"status":"%s","type":"%s","error":%s,`+
+				`"enqueuedAt":"2023-02-08T10:00:00.000000Z","finishedAt":%s}`, uid, status, tasks[uid], taskError, finishedAt)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestReindexAtomic(t *testing.T) {
+	type movie struct {
+		ID    string `json:"movie_id"`
+		Title string `json:"title"`
+	}
+	documents := []movie{{ID: "1", Title: "Carol"}, {ID: "2", Title: "Wonder Woman"}}
+	settings := Settings{SearchableAttributes: []string{"title"}}
+	loaded := []string{
+		"GET /indexes/movies",
+		"POST /indexes",
+		"GET /tasks/0",
+		"GET /tasks/0",
+		"POST /indexes/<tmp>/settings",
+		"GET /tasks/1",
+		"GET /tasks/1",
+		"POST /indexes/<tmp>/documents",
+		"GET /tasks/2",
+		"GET /tasks/2",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var calls []string
+		var tmpUID string
+		bodies := map[string]string{}
+		c := newReindexMockClient(t, "", "", &calls, bodies, &tmpUID)
+
+		if err := ReindexAtomic(context.Background(), c, "movies", documents, settings); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, append(loaded,
+			"POST /swap-indexes",
+			"GET /tasks/3",
+			"GET /tasks/3",
+			"DELETE /indexes/<tmp>",
+		), calls)
+		assert.Regexp(t, `^movies_reindex_\d+$`, tmpUID)
+		assert.JSONEq(t, `{"uid":"`+tmpUID+`","primaryKey":"movie_id"}`, bodies["POST /indexes"])
+		assert.JSONEq(t, `{"searchableAttributes":["title"]}`, bodies["POST /indexes/<tmp>/settings"])
+		assert.JSONEq(t, `[{"movie_id":"1","title":"Carol"},{"movie_id":"2","title":"Wonder Woman"}]`,
+			bodies["POST /indexes/<tmp>/documents"])
+		assert.JSONEq(t, `[{"indexes":["movies","`+tmpUID+`"]}]`, bodies["POST /swap-indexes"])
+
+		// a second run does not reuse the uid of the first one, which may have been left behind
+		first := tmpUID
+		if err := ReindexAtomic(context.Background(), c, "movies", documents, settings); err != nil {
+			t.Fatal(err)
+		}
+		assert.NotEqual(t, first, tmpUID)
+	})
+
+	t.Run("failure cleanup", func(t *testing.T) {
+		var calls []string
+		var tmpUID string
+		c := newReindexMockClient(t, "documentAdditionOrUpdate", "", &calls, map[string]string{}, &tmpUID)
+
+		err := ReindexAtomic(context.Background(), c, "movies", documents, settings)
+		if err == nil {
+			t.Fatal("the failed task should be reported")
+		}
+		assert.Contains(t, err.Error(), `task 2 ended with status failed: Document identifier `+"`"+`"1 2"`+"`")
+		assert.Equal(t, append(loaded, "DELETE /indexes/<tmp>"), calls)
+	})
+
+	t.Run("failed swap", func(t *testing.T) {
+		var calls []string
+		var tmpUID string
+		c := newReindexMockClient(t, "indexSwap", "", &calls, map[string]string{}, &tmpUID)
+
+		err := ReindexAtomic(context.Background(), c, "movies", documents, settings)
+		if err == nil {
+			t.Fatal("the failed swap should be reported")
+		}
+		assert.Equal(t, append(loaded, "POST /swap-indexes", "GET /tasks/3", "GET /tasks/3", "DELETE /indexes/<tmp>"),
+			calls)
+	})
+
+	t.Run("pending swap", func(t *testing.T) {
+		var calls []string
+		var tmpUID string
+		c := newReindexMockClient(t, "", "indexSwap", &calls, map[string]string{}, &tmpUID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		err := ReindexAtomic(ctx, c, "movies", documents, settings)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v should be context.DeadlineExceeded", err)
+		assert.Contains(t, err.Error(), tmpUID+" is left")
+		assert.NotContains(t, calls, "DELETE /indexes/<tmp>", "the index may hold the new content")
+	})
+}
@@ -1,16 +1,80 @@
 package meilisearch
 
-import "net/http"
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// keyActions are the actions a key can be restricted to.
+var keyActions = map[string]bool{
+	"*":                true,
+	"search":           true,
+	"documents.*":      true,
+	"documents.add":    true,
+	"documents.get":    true,
+	"documents.delete": true,
+	"indexes.*":        true,
+	"indexes.create":   true,
+	"indexes.get":      true,
+	"indexes.update":   true,
+	"indexes.delete":   true,
+	"indexes.swap":     true,
+	"tasks.*":          true,
+	"tasks.get":        true,
+	"tasks.cancel":     true,
+	"tasks.delete":     true,
+	"settings.*":       true,
+	"settings.get":     true,
+	"settings.update":  true,
+	"stats.*":          true,
+	"stats.get":        true,
+	"dumps.*":          true,
+	"dumps.create":     true,
+	"snapshots.*":      true,
+	"snapshots.create": true,
+	"version":          true,
+	"keys.get":         true,
+	"keys.create":      true,
+	"keys.update":      true,
+	"keys.delete":      true,
+}
 
 type clientKeys struct {
 	client *Client
+	cache  *keysCache
+}
+
+// keysCache holds the last default keys fetched by a client when Config.KeysCacheTTL is set.
+type keysCache struct {
+	mu        sync.RWMutex
+	keys      *Keys
+	expiresAt time.Time
 }
 
 func newClientKeys(client *Client) clientKeys {
-	return clientKeys{client: client}
+	return clientKeys{client: client, cache: &keysCache{}}
 }
 
 func (c clientKeys) Get() (resp *Keys, err error) {
+	c.cache.mu.RLock()
+	cached, expiresAt := c.cache.keys, c.cache.expiresAt
+	c.cache.mu.RUnlock()
+
+	if cached != nil && time.Now().Before(expiresAt) {
+		keys := *cached
+		return &keys, nil
+	}
+	return c.fetch("Get")
+}
+
+func (c clientKeys) Refresh() (resp *Keys, err error) {
+	return c.fetch("Refresh")
+}
+
+func (c clientKeys) fetch(functionName string) (resp *Keys, err error) {
 	resp = &Keys{}
 	req := internalRequest{
 		endpoint:            "/keys",
@@ -18,12 +82,125 @@ func (c clientKeys) Get() (resp *Keys, err error) {
 		withRequest:         nil,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
-		functionName:        "Get",
+		functionName:        functionName,
 		apiName:             "Keys",
 	}
 
 	if err := c.client.executeRequest(req); err != nil {
 		return nil, err
 	}
+
+	if ttl := c.client.config.KeysCacheTTL; ttl > 0 {
+		keys := *resp
+		c.cache.mu.Lock()
+		c.cache.keys, c.cache.expiresAt = &keys, time.Now().Add(ttl)
+		c.cache.mu.Unlock()
+	}
+
 	return resp, nil
 }
+
+func (c clientKeys) CreateKey(request CreateKeyRequest) (resp *Key, err error) {
+	resp = &Key{}
+	req := internalRequest{
+		endpoint:            "/keys",
+		method:              http.MethodPost,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusCreated},
+		functionName:        "CreateKey",
+		apiName:             "Keys",
+	}
+
+	if err := validateCreateKeyRequest(request); err != nil {
+		return nil, newError(req).WithErrCode(ErrCodeRequestValidation, err)
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientKeys) GetKey(keyOrUID string) (resp *Key, err error) {
+	resp = &Key{}
+	req := internalRequest{
+		endpoint:            "/keys/" + keyOrUID,
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetKey",
+		apiName:             "Keys",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientKeys) ListKeys() (resp *KeysResults, err error) {
+	resp = &KeysResults{}
+	req := internalRequest{
+		endpoint:            "/keys",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "ListKeys",
+		apiName:             "Keys",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientKeys) UpdateKey(keyOrUID string, request UpdateKeyRequest) (resp *Key, err error) {
+	resp = &Key{}
+	req := internalRequest{
+		endpoint:            "/keys/" + keyOrUID,
+		method:              http.MethodPatch,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "UpdateKey",
+		apiName:             "Keys",
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientKeys) DeleteKey(keyOrUID string) error {
+	req := internalRequest{
+		endpoint:            "/keys/" + keyOrUID,
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        nil,
+		acceptedStatusCodes: []int{http.StatusNoContent},
+		functionName:        "DeleteKey",
+		apiName:             "Keys",
+	}
+
+	return c.client.executeRequest(req)
+}
+
+func validateCreateKeyRequest(request CreateKeyRequest) error {
+	if len(request.Actions) == 0 {
+		return errors.New("at least one action is required")
+	}
+	for _, action := range request.Actions {
+		if !keyActions[action] {
+			return errors.Errorf("unknown action %q", action)
+		}
+	}
+	if len(request.Indexes) == 0 {
+		return errors.New("at least one index is required, use \"*\" for all indexes")
+	}
+	return nil
+}
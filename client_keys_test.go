@@ -1,7 +1,12 @@
 package meilisearch
 
 import (
+	"io/ioutil"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientKeys_Get(t *testing.T) {
@@ -9,3 +14,206 @@ func TestClientKeys_Get(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClientKeys_CreateKey(t *testing.T) {
+	var body []byte
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/keys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"name":"movies-search","key":"d0552b41","actions":["search"],"indexes":["movies"],"expiresAt":null,"createdAt":"2021-08-11T10:00:00Z","updatedAt":"2021-08-11T10:00:00Z"}`))
+	})
+
+	key, err := c.Keys().CreateKey(CreateKeyRequest{
+		Name:    "movies-search",
+		Actions: []string{"search"},
+		Indexes: []string{"movies"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.JSONEq(t, `{"name":"movies-search","actions":["search"],"indexes":["movies"],"expiresAt":null}`, string(body))
+	assert.Equal(t, "d0552b41", key.Key)
+	assert.Equal(t, []string{"movies"}, key.Indexes)
+	assert.Nil(t, key.ExpiresAt)
+}
+
+func TestClientKeys_CreateKeyInvalid(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("an invalid request should not be sent")
+	})
+
+	requests := []CreateKeyRequest{
+		{Actions: []string{"serach"}, Indexes: []string{"movies"}},
+		{Actions: nil, Indexes: []string{"movies"}},
+		{Actions: []string{"search"}, Indexes: nil},
+	}
+	for _, request := range requests {
+		_, err := c.Keys().CreateKey(request)
+		if err == nil {
+			t.Fatalf("%v should be rejected", request)
+		}
+		if err.(*Error).ErrCode != ErrCodeRequestValidation {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestClientKeys_Refresh(t *testing.T) {
+	private := "8dcbb482663333d0280fa9fedf0e0c16d52185cb67db494ce4cd34da32ce2092"
+	calls := 0
+	c := newMockClient(t, Config{KeysCacheTTL: time.Hour}, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"private":"` + private + `","public":"3b3bf839485f90453acc6159ba18fbed673ca88523093def11a9b4f4320e44a5"}`))
+	})
+
+	keys, err := c.Keys().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, private, keys.Private)
+
+	// The master key changes, so do the default keys.
+	private = "2f0cb3f64bda2bb5f4fa8fa1e8c2b2b8a0cba2e3e42a10b5f0c5f2c8b8e0c6b1"
+
+	keys, err = c.Keys().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, private, keys.Private, "Get should use the keys held by the client")
+	assert.Equal(t, 1, calls)
+
+	keys, err = c.Keys().Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, private, keys.Private)
+	assert.Equal(t, 2, calls)
+
+	keys, err = c.Keys().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, private, keys.Private)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClientKeys_Management(t *testing.T) {
+	const key = `{"uid":"01b4bc42-eb33-4041-b481-254d00cce834","name":"tenant-1","description":"Search the movies of tenant 1",` +
+		`"key":"d0552b41","actions":["search"],"indexes":["movies_1"],"expiresAt":"2030-01-01T00:00:00Z",` +
+		`"createdAt":"2021-08-11T10:00:00Z","updatedAt":"2021-08-12T10:00:00Z"}`
+	var calls []string
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(key))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/keys":
+			_, _ = w.Write([]byte(`{"results":[` + key + `],"offset":0,"limit":20,"total":1}`))
+		case r.URL.Path == "/keys/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"API key missing not found","code":"api_key_not_found"}`))
+		default:
+			_, _ = w.Write([]byte(key))
+		}
+	})
+
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	created, err := c.Keys().CreateKey(CreateKeyRequest{
+		Name:        "tenant-1",
+		Description: "Search the movies of tenant 1",
+		Actions:     []string{"search"},
+		Indexes:     []string{"movies_1"},
+		ExpiresAt:   &expiresAt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"name":"tenant-1","description":"Search the movies of tenant 1","actions":["search"],`+
+		`"indexes":["movies_1"],"expiresAt":"2030-01-01T00:00:00Z"}`, body)
+	assert.Equal(t, "01b4bc42-eb33-4041-b481-254d00cce834", created.UID)
+	assert.Equal(t, "d0552b41", created.Key)
+	assert.True(t, expiresAt.Equal(*created.ExpiresAt))
+
+	_, err = c.Keys().CreateKey(CreateKeyRequest{Actions: []string{"serach"}, Indexes: []string{"*"}})
+	assert.Equal(t, ErrCodeRequestValidation, err.(*Error).ErrCode)
+
+	got, err := c.Keys().GetKey(created.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, created, got)
+
+	_, err = c.Keys().GetKey("missing")
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*Error).StatusCode)
+		assert.Equal(t, "api_key_not_found", err.(*Error).MeilisearchCode)
+	}
+
+	list, err := c.Keys().ListKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1), list.Total)
+	assert.Equal(t, []Key{*created}, list.Results)
+
+	updated, err := c.Keys().UpdateKey(created.Key, UpdateKeyRequest{Description: "Search the movies of tenant 1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"description":"Search the movies of tenant 1"}`, body)
+	assert.Equal(t, created.UID, updated.UID)
+
+	if err := c.Keys().DeleteKey(created.UID); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{
+		"POST /keys",
+		"GET /keys/01b4bc42-eb33-4041-b481-254d00cce834",
+		"GET /keys/missing",
+		"GET /keys",
+		"PATCH /keys/d0552b41",
+		"DELETE /keys/01b4bc42-eb33-4041-b481-254d00cce834",
+	}, calls)
+}
+
+func TestKeysResults_UnmarshalJSON(t *testing.T) {
+	var results KeysResults
+	if err := results.UnmarshalJSON([]byte(` [{"key":"d0552b41","actions":["*"],"indexes":["*"]}]`)); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, KeysResults{Results: []Key{{Key: "d0552b41", Actions: []string{"*"}, Indexes: []string{"*"}}}}, results)
+}
+
+func TestClientKeys_Get_Uncached(t *testing.T) {
+	private := "8dcbb482663333d0280fa9fedf0e0c16d52185cb67db494ce4cd34da32ce2092"
+	calls := 0
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"private":"` + private + `","public":"3b3bf839485f90453acc6159ba18fbed673ca88523093def11a9b4f4320e44a5"}`))
+	})
+
+	if _, err := c.Keys().Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The master key changes, so do the default keys.
+	private = "2f0cb3f64bda2bb5f4fa8fa1e8c2b2b8a0cba2e3e42a10b5f0c5f2c8b8e0c6b1"
+
+	keys, err := c.Keys().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, private, keys.Private, "Get should fetch the keys without Config.KeysCacheTTL")
+	assert.Equal(t, 2, calls)
+}
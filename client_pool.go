@@ -0,0 +1,170 @@
+package meilisearch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// poolFailureThreshold is the number of failures in a row after which a host of a pool is unhealthy
+	poolFailureThreshold = 3
+
+	// poolProbeInterval is how often the health of an unhealthy host of a pool is checked again
+	poolProbeInterval = 10 * time.Second
+)
+
+// NewClientPool creates a client spreading the reads, the GET requests and the searches, across hosts in turn.
+// A host failing poolFailureThreshold times in a row, because of a connection error or a 5xx status, is
+// unhealthy: it is skipped until its /health endpoint, checked again every poolProbeInterval while the client is
+// used, replies. A read failing on a host is sent to the next healthy one. The other requests, which change the
+// data, are only sent to the primary: Config.WriteHost or Config.Host, which defaults to the first host. So are the
+// reads which depend on the writes, such as the status of the updates and tasks, which the other hosts may not
+// know yet, see Config.ReadHost.
+func NewClientPool(config Config, hosts []string) ClientInterface {
+	if config.Host == "" && len(hosts) != 0 {
+		config.Host = hosts[0]
+	}
+	c := NewClient(config).(*Client)
+	if len(hosts) == 0 {
+		return c
+	}
+	c.pool = newHostPool(hosts, func(host string) error {
+		_, err := c.executeAttempt(context.Background(), internalRequest{
+			endpoint:            "/health",
+			method:              http.MethodGet,
+			host:                host,
+			acceptedStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+			functionName:        "Get",
+			apiName:             "Health",
+		})
+		return err
+	})
+	return c
+}
+
+// hostPool holds the health of the hosts of a client created by NewClientPool.
+type hostPool struct {
+	mu    sync.Mutex
+	hosts []*poolHost
+	next  int
+
+	probeInterval time.Duration
+	probe         func(host string) error
+
+	// now and startProbe are replaced by the tests to drive the probes deterministically
+	now        func() time.Time
+	startProbe func(probe func())
+}
+
+type poolHost struct {
+	url       string
+	failures  int
+	nextProbe time.Time
+	probing   bool
+}
+
+func newHostPool(hosts []string, probe func(host string) error) *hostPool {
+	pool := &hostPool{
+		probeInterval: poolProbeInterval,
+		probe:         probe,
+		now:           time.Now,
+		startProbe:    func(probe func()) { go probe() },
+	}
+	for _, host := range hosts {
+		pool.hosts = append(pool.hosts, &poolHost{url: host})
+	}
+	return pool
+}
+
+// candidates returns the healthy hosts in the order a read should try them, starting at the next host in turn.
+// If no host is healthy, all of them are returned. The unhealthy hosts due for a probe are probed in the
+// background.
+func (p *hostPool) candidates() []string {
+	p.mu.Lock()
+	var healthy, all []string
+	var due []*poolHost
+	for i := range p.hosts {
+		host := p.hosts[(p.next+i)%len(p.hosts)]
+		all = append(all, host.url)
+		if host.failures < poolFailureThreshold {
+			healthy = append(healthy, host.url)
+			continue
+		}
+		if !host.probing && p.now().After(host.nextProbe) {
+			host.probing = true
+			due = append(due, host)
+		}
+	}
+	p.next = (p.next + 1) % len(p.hosts)
+	p.mu.Unlock()
+
+	for _, host := range due {
+		host := host
+		p.startProbe(func() { p.reprobe(host) })
+	}
+
+	if len(healthy) == 0 {
+		return all
+	}
+	return healthy
+}
+
+// reprobe checks the health of an unhealthy host, which is healthy again if it replies.
+func (p *hostPool) reprobe(host *poolHost) {
+	err := p.probe(host.url)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	host.probing = false
+	if err == nil {
+		host.failures = 0
+		return
+	}
+	host.nextProbe = p.now().Add(p.probeInterval)
+}
+
+// report records whether a request sent to url failed.
+func (p *hostPool) report(url string, failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, host := range p.hosts {
+		if host.url != url {
+			continue
+		}
+		if !failed {
+			host.failures = 0
+			return
+		}
+		host.failures++
+		if host.failures == poolFailureThreshold {
+			host.nextProbe = p.now().Add(p.probeInterval)
+		}
+		return
+	}
+}
+
+// executePooledAttempt sends a read req to the healthy hosts of the pool in turn until one of them does not fail
+// with a transient error. The requests streaming their body from an io.Reader are only sent to the first host.
+func (c *Client) executePooledAttempt(ctx context.Context, req internalRequest) (int, error) {
+	var (
+		statusCode int
+		err        error
+	)
+	for _, host := range c.pool.candidates() {
+		req.host = host
+		statusCode, err = c.executeAttempt(ctx, req)
+		failed := err != nil && c.isTransient(ctx, err)
+		c.pool.report(host, failed)
+		if !failed {
+			return statusCode, err
+		}
+		if _, streamed := req.withRequest.(io.Reader); streamed {
+			break
+		}
+	}
+	return statusCode, err
+}
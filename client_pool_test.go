@@ -0,0 +1,148 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClientPool(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string][]string{}
+	aDown := true
+	newServer := func(name string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			calls[name] = append(calls[name], r.Method+" "+r.URL.Path)
+			down := name == "a" && aDown
+			mu.Unlock()
+
+			if down {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"message":"restarting"}`))
+				return
+			}
+			if r.Method == http.MethodDelete {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			_, _ = w.Write([]byte(`{"pkgVersion":"` + name + `","status":"available"}`))
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	a, b := newServer("a"), newServer("b")
+	callsOf := func(name string) []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), calls[name]...)
+	}
+
+	c := NewClientPool(Config{}, []string{a.URL, b.URL}).(*Client)
+	now := time.Now()
+	c.pool.now = func() time.Time { return now }
+	c.pool.startProbe = func(probe func()) { probe() }
+
+	for i := 0; i < 6; i++ {
+		version, err := c.Version().Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "b", version.PkgVersion, "the reads should fail over to the healthy host")
+	}
+	assert.Equal(t, poolFailureThreshold, len(callsOf("a")), "an unhealthy host should be skipped")
+	assert.Equal(t, 6, len(callsOf("b")))
+
+	_, err := c.Indexes().Delete("movies")
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusServiceUnavailable, err.(*Error).StatusCode)
+	}
+	assert.NotContains(t, callsOf("b"), "DELETE /indexes/movies", "the writes should only go to the primary")
+
+	mu.Lock()
+	aDown = false
+	mu.Unlock()
+	if _, err := c.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, callsOf("a"), "GET /health", "the host should not be probed before poolProbeInterval")
+
+	now = now.Add(poolProbeInterval + time.Second)
+	var versions []string
+	for i := 0; i < 3; i++ {
+		version, err := c.Version().Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, version.PkgVersion)
+	}
+	assert.Contains(t, callsOf("a"), "GET /health")
+	assert.Contains(t, versions, "a", "the host should be used again once its health check succeeds")
+}
+
+func TestNewClientPool_WriteStatus(t *testing.T) {
+	var mu sync.Mutex
+	var replicaCalls []string
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/tasks/4":
+			_, _ = w.Write([]byte(`{"uid":4,"status":"succeeded","type":"documentAdditionOrUpdate","error":null}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"rankingRules":["words"]}`))
+		default:
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":4}`))
+		}
+	}))
+	t.Cleanup(primary.Close)
+	replica := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		replicaCalls = append(replicaCalls, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Task not found.","code":"task_not_found"}`))
+	}))
+	t.Cleanup(replica.Close)
+
+	c := NewClientPool(Config{}, []string{primary.URL, replica.URL})
+	updateID, err := c.Documents("movies").AddOrReplace([]map[string]interface{}{{"id": 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		status, err := c.WaitForPendingUpdate(context.Background(), time.Millisecond, "movies", updateID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, UpdateStatusSucceeded, status)
+	}
+	err = c.Settings("movies").UpdateWithRollback(func(settings APISettings) error {
+		_, err := settings.UpdateRankingRules([]string{"typo"})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Empty(t, replicaCalls, "the status of the writes and the snapshots should be read from the primary")
+}
+
+func TestNewClientPool_AllDown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClientPool(Config{}, []string{server.URL, "http://127.0.0.1:1"})
+	for i := 0; i < poolFailureThreshold+1; i++ {
+		_, err := c.Version().Get()
+		assert.Error(t, err, "the reads should fail when no host is healthy")
+	}
+}
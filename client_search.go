@@ -1,7 +1,14 @@
 package meilisearch
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
 type clientSearch struct {
@@ -15,30 +22,204 @@ func newClientSearch(client *Client, indexUID string) clientSearch {
 
 func (c clientSearch) Search(request SearchRequest) (*SearchResponse, error) {
 
+	resp := &SearchResponse{}
+	if err := c.search(request, resp, "Search"); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSearch) SearchRaw(request SearchRequest) ([]byte, error) {
+
+	var resp RawType
+	if err := c.search(request, &resp, "SearchRaw"); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// search sends request with a POST request, the response is decoded into resp.
+func (c clientSearch) search(request SearchRequest, resp interface{}, functionName string) error {
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/search",
+		method:              http.MethodPost,
+		withRequest:         searchParams(request),
+		withResponse:        resp,
+		withQueryParams:     request.ExtraQueryParams,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        functionName,
+		apiName:             "Search",
+	}
+
+	if err := c.validateSearchRequest(req, request); err != nil {
+		return err
+	}
+
+	return c.client.executeRequest(req)
+}
+
+func (c clientSearch) SearchGet(request SearchRequest) (*SearchResponse, error) {
+
 	resp := &SearchResponse{}
 
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/search",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		withQueryParams:     map[string]string{},
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "SearchGet",
+		apiName:             "Search",
+	}
+
+	if err := c.validateSearchRequest(req, request); err != nil {
+		return nil, err
+	}
+
+	for key, value := range searchParams(request) {
+		switch value := value.(type) {
+		case string:
+			req.withQueryParams[key] = value
+		case int64:
+			req.withQueryParams[key] = strconv.FormatInt(value, 10)
+		case int:
+			req.withQueryParams[key] = strconv.Itoa(value)
+		case bool:
+			req.withQueryParams[key] = strconv.FormatBool(value)
+		case float64:
+			req.withQueryParams[key] = strconv.FormatFloat(value, 'f', -1, 64)
+		case []string:
+			req.withQueryParams[key] = strings.Join(value, ",")
+		default:
+			// e.g. the FacetFilters, which are nested arrays
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, newError(req).WithErrCode(ErrCodeMarshalRequest, err)
+			}
+			req.withQueryParams[key] = string(data)
+		}
+	}
+	for key, value := range request.ExtraQueryParams {
+		req.withQueryParams[key] = value
+	}
+
+	if err := c.client.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ParseSearchRequest builds a SearchRequest from the parameters of a url, e.g. received by a search proxy: q,
+// limit, offset, filter, sort and facets, the last two being comma-separated lists. The other parameters are
+// ignored. An error is returned if limit or offset is not a non-negative integer.
+func ParseSearchRequest(values url.Values) (SearchRequest, error) {
+	request := SearchRequest{
+		Query:              values.Get("q"),
+		Filter:             values.Get("filter"),
+		Sort:               splitSearchParam(values.Get("sort")),
+		FacetsDistribution: splitSearchParam(values.Get("facets")),
+	}
+
+	for _, param := range []struct {
+		name  string
+		value *int64
+	}{{"limit", &request.Limit}, {"offset", &request.Offset}} {
+		raw := values.Get(param.name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || value < 0 {
+			return SearchRequest{}, errors.Errorf("%s must be a non-negative integer, got %q", param.name, raw)
+		}
+		*param.value = value
+	}
+	return request, nil
+}
+
+// splitSearchParam splits a comma-separated list, nil being returned for an empty list.
+func splitSearchParam(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// validateSearchRequest checks the fields of request MeiliSearch would reject, req being the request it is sent in.
+// The filter is only checked if Config.ValidateFilters is set.
+func (c clientSearch) validateSearchRequest(req internalRequest, request SearchRequest) error {
+	if threshold := request.RankingScoreThreshold; threshold != nil && (*threshold < 0 || *threshold > 1) {
+		return newError(req).WithErrCode(ErrCodeRequestValidation,
+			errors.Errorf("rankingScoreThreshold must be between 0 and 1, got %v", *threshold))
+	}
+
+	if !c.client.config.ValidateFilters || request.Filter == "" {
+		return nil
+	}
+	attributes, err := c.notFilterable(request.Filter)
+	if err != nil || len(attributes) == 0 {
+		return err
+	}
+
+	// the cached settings may predate a change of the filterable attributes, e.g. by another client, so they
+	// are fetched again before rejecting the filter
+	c.client.settingsCache.drop(c.indexUID)
+	if attributes, err = c.notFilterable(request.Filter); err != nil || len(attributes) == 0 {
+		return err
+	}
+	return newError(req).WithErrCode(ErrCodeRequestValidation,
+		errors.Errorf("attributes %s of the filter are not filterable", strings.Join(attributes, ", ")))
+}
+
+// notFilterable returns the attributes of filter which are not filterable according to the cached settings.
+func (c clientSearch) notFilterable(filter string) ([]string, error) {
+	settings, err := c.client.cachedSettings(c.indexUID)
+	if err != nil {
+		return nil, err
+	}
+	return notFilterable(filter, settings.FilterableAttributes), nil
+}
+
+// searchParams returns the parameters of the search sent for request, the default values being left out.
+func searchParams(request SearchRequest) map[string]interface{} {
 	searchPostRequestParams := map[string]interface{}{}
 
 	if request.Limit == 0 {
 		request.Limit = 20
 	}
 
-	if !request.PlaceholderSearch {
-		searchPostRequestParams["q"] = request.Query
+	// q is always sent, empty for a placeholder search, as some versions require it
+	searchPostRequestParams["q"] = request.Query
+	if request.PlaceholderSearch {
+		searchPostRequestParams["q"] = ""
 	}
 	if request.Filters != "" {
 		searchPostRequestParams["filters"] = request.Filters
 	}
-	if request.Offset != 0 {
-		searchPostRequestParams["offset"] = request.Offset
+	if request.Filter != "" {
+		searchPostRequestParams["filter"] = request.Filter
 	}
-	if request.Limit != 20 {
-		searchPostRequestParams["limit"] = request.Limit
+	if request.CountOnly {
+		searchPostRequestParams["hitsPerPage"] = 0
+	} else {
+		if request.Offset != 0 {
+			searchPostRequestParams["offset"] = request.Offset
+		}
+		if request.Limit != 20 {
+			searchPostRequestParams["limit"] = request.Limit
+		}
 	}
 	if request.CropLength != 0 {
 		searchPostRequestParams["cropLength"] = request.CropLength
 	}
-	if len(request.AttributesToRetrieve) != 0 {
+	if request.AttributesToRetrieve != nil {
 		searchPostRequestParams["attributesToRetrieve"] = request.AttributesToRetrieve
 	}
 	if len(request.AttributesToCrop) != 0 {
@@ -47,6 +228,15 @@ func (c clientSearch) Search(request SearchRequest) (*SearchResponse, error) {
 	if len(request.AttributesToHighlight) != 0 {
 		searchPostRequestParams["attributesToHighlight"] = request.AttributesToHighlight
 	}
+	if request.HighlightPreTag != "" {
+		searchPostRequestParams["highlightPreTag"] = request.HighlightPreTag
+	}
+	if request.HighlightPostTag != "" {
+		searchPostRequestParams["highlightPostTag"] = request.HighlightPostTag
+	}
+	if request.CropMarker != "" {
+		searchPostRequestParams["cropMarker"] = request.CropMarker
+	}
 	if request.Matches {
 		searchPostRequestParams["matches"] = request.Matches
 	}
@@ -56,17 +246,31 @@ func (c clientSearch) Search(request SearchRequest) (*SearchResponse, error) {
 	if request.FacetFilters != nil {
 		searchPostRequestParams["facetFilters"] = request.FacetFilters
 	}
+	if len(request.Sort) != 0 {
+		searchPostRequestParams["sort"] = request.Sort
+	}
+	if request.RankingScoreThreshold != nil {
+		searchPostRequestParams["rankingScoreThreshold"] = *request.RankingScoreThreshold
+	}
+	return searchPostRequestParams
+}
 
+func (c clientSearch) FacetSearch(request FacetSearchRequest) (resp *FacetSearchResponse, err error) {
+	resp = &FacetSearchResponse{}
 	req := internalRequest{
-		endpoint:            "/indexes/" + c.indexUID + "/search",
+		endpoint:            "/indexes/" + c.indexUID + "/facet-search",
 		method:              http.MethodPost,
-		withRequest:         searchPostRequestParams,
+		withRequest:         &request,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
-		functionName:        "Search",
+		functionName:        "FacetSearch",
 		apiName:             "Search",
 	}
 
+	if request.FacetName == "" {
+		return nil, newError(req).WithErrCode(ErrCodeRequestValidation, errors.New("facetName is required"))
+	}
+
 	if err := c.client.executeRequest(req); err != nil {
 		return nil, err
 	}
@@ -74,6 +278,154 @@ func (c clientSearch) Search(request SearchRequest) (*SearchResponse, error) {
 	return resp, nil
 }
 
+func (c clientSearch) SearchWithFallback(ctx context.Context, request SearchRequest,
+	fallback []interface{}) (*SearchResponse, error) {
+
+	// the search is aborted once ctx is done, so that it does not outlive the call
+	resp, err := c.client.WithContext(ctx).Search(c.indexUID).Search(request)
+	if err == nil {
+		return resp, nil
+	}
+	if ctx.Err() != nil || searchUnavailable(err) {
+		return fallbackSearchResponse(request, fallback), nil
+	}
+	return nil, err
+}
+
+// searchUnavailable tells if a search failed because MeiliSearch could not answer it: it could not be reached, did
+// not reply in time, see Config.Timeout, closed the connection before the end of the response or replied with a
+// 5xx status.
+func searchUnavailable(err error) bool {
+	e, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	return e.ErrCode == ErrCodeRequestExecution || errors.Is(err, ErrIncompleteResponse) ||
+		e.StatusCode >= http.StatusInternalServerError
+}
+
+func (c clientSearch) NotDisplayedAttributes(attributesToRetrieve []string) ([]string, error) {
+	settings, err := c.client.cachedSettings(c.indexUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// no displayed attributes is the default, every attribute being displayed
+	notDisplayed := []string{}
+	if settings.DisplayedAttributes == nil || containsString(settings.DisplayedAttributes, "*") {
+		return notDisplayed, nil
+	}
+	for _, attribute := range attributesToRetrieve {
+		if attribute != "*" && !containsString(settings.DisplayedAttributes, attribute) {
+			notDisplayed = append(notDisplayed, attribute)
+		}
+	}
+	return notDisplayed, nil
+}
+
+func (c clientSearch) StableExport(ctx context.Context, request SearchRequest, primaryKey string,
+	fn func(hits []interface{}) error) error {
+
+	var invalid error
+	switch {
+	case request.Offset != 0:
+		invalid = errors.New("offset must not be set, StableExport pages on the primary key")
+	case request.Filters != "":
+		// the versions supporting sort only read filter
+		invalid = errors.New("filters must not be set, StableExport sorts the hits, use filter instead")
+	}
+	if invalid != nil {
+		req := internalRequest{
+			endpoint:     "/indexes/" + c.indexUID + "/search",
+			method:       http.MethodPost,
+			functionName: "StableExport",
+			apiName:      "Search",
+		}
+		return newError(req).WithErrCode(ErrCodeRequestValidation, invalid)
+	}
+
+	filter := request.Filter
+	request.Sort = []string{primaryKey + ":asc"}
+	request.CountOnly = false
+	if request.Limit == 0 {
+		request.Limit = 20
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		resp, err := c.Search(request)
+		if err != nil {
+			return err
+		}
+		if len(resp.Hits) == 0 {
+			return nil
+		}
+		full := int64(len(resp.Hits)) >= request.Limit
+
+		// the key of the next page is read first, so that fn is not called if the export cannot go on
+		var last json.Number
+		if full {
+			if last, err = lastPrimaryKey(resp, primaryKey); err != nil {
+				return err
+			}
+		}
+		if err := fn(resp.Hits); err != nil {
+			return err
+		}
+		if !full {
+			return nil
+		}
+
+		request.Filter = primaryKey + " > " + last.String()
+		if filter != "" {
+			request.Filter = "(" + filter + ") AND " + request.Filter
+		}
+	}
+}
+
+// lastPrimaryKey returns the primary key of the last hit of resp as the number MeiliSearch sent, without going
+// through a float64 which would round the big integers. MeiliSearch only filters numbers with >, so an error is
+// returned for any other primary key, e.g. a string.
+func lastPrimaryKey(resp *SearchResponse, primaryKey string) (json.Number, error) {
+	data := []byte(resp.rawHits)
+	if data == nil {
+		var err error
+		if data, err = json.Marshal(resp.Hits); err != nil {
+			return "", err
+		}
+	}
+	var hits []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &hits); err != nil {
+		return "", err
+	}
+
+	value, ok := hits[len(hits)-1][primaryKey]
+	if !ok {
+		return "", errors.Errorf("hit without primary key %q", primaryKey)
+	}
+	if len(value) == 0 || (value[0] != '-' && (value[0] < '0' || value[0] > '9')) {
+		return "", errors.Errorf("StableExport requires a numeric primary key, %q is %s", primaryKey, value)
+	}
+	return json.Number(value), nil
+}
+
+func fallbackSearchResponse(request SearchRequest, fallback []interface{}) *SearchResponse {
+	if fallback == nil {
+		fallback = []interface{}{}
+	}
+	return &SearchResponse{
+		Hits:     fallback,
+		NbHits:   int64(len(fallback)),
+		Offset:   request.Offset,
+		Limit:    request.Limit,
+		Query:    request.Query,
+		Degraded: true,
+	}
+}
+
 func (c clientSearch) IndexID() string {
 	return c.indexUID
 }
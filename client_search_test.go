@@ -1,8 +1,19 @@
 package meilisearch
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientSearch_Search(t *testing.T) {
@@ -327,3 +338,802 @@ func TestClientSearch_Search(t *testing.T) {
 	}
 
 }
+
+func TestClientSearch_RankingScoreThreshold(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[{"book_id":456,"title":"Le Petit Prince"}],"nbHits":1,"offset":0,"limit":20,"processingTimeMs":1,"query":"prince"}`))
+	})
+
+	threshold := 0.8
+	resp, err := c.Search("TestClientSearch_RankingScoreThreshold").Search(SearchRequest{
+		Query:                 "prince",
+		RankingScoreThreshold: &threshold,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"q":"prince","rankingScoreThreshold":0.8}`, body)
+	assert.Equal(t, int64(1), resp.NbHits)
+
+	body = ""
+	for _, invalid := range []float64{-0.1, 1.5} {
+		threshold := invalid
+		_, err = c.Search("TestClientSearch_RankingScoreThreshold").Search(SearchRequest{
+			Query:                 "prince",
+			RankingScoreThreshold: &threshold,
+		})
+		if err == nil || err.(*Error).ErrCode != ErrCodeRequestValidation {
+			t.Fatalf("%v should be rejected, got %v", invalid, err)
+		}
+	}
+	assert.Empty(t, body, "an invalid request should not be sent")
+}
+
+func TestClientSearch_FacetSearch(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/indexes/books/facet-search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"facetHits":[{"value":"fiction","count":7},{"value":"fantasy","count":3}],"facetQuery":"fi","processingTimeMs":0}`))
+	})
+
+	resp, err := c.Search("books").FacetSearch(FacetSearchRequest{
+		FacetName:  "genres",
+		FacetQuery: "fi",
+		Query:      "prince",
+		Filter:     "rating > 3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.JSONEq(t, `{"facetName":"genres","facetQuery":"fi","q":"prince","filter":"rating > 3"}`, body)
+	assert.Equal(t, []FacetHit{{Value: "fiction", Count: 7}, {Value: "fantasy", Count: 3}}, resp.FacetHits)
+	assert.Equal(t, "fi", resp.FacetQuery)
+
+	if _, err := c.Search("books").FacetSearch(FacetSearchRequest{FacetQuery: "fi"}); err == nil {
+		t.Fatal("a facet search without facet name should be rejected")
+	}
+}
+
+func TestClientSearch_SearchWithFallback(t *testing.T) {
+	fallback := []interface{}{map[string]interface{}{"id": "1", "title": "cached"}}
+
+	t.Run("timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		resp, err := c.Search("movies").SearchWithFallback(ctx, SearchRequest{Query: "prince"}, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, resp.Degraded)
+		assert.Equal(t, fallback, resp.Hits)
+		assert.Equal(t, int64(1), resp.NbHits)
+		assert.Equal(t, "prince", resp.Query)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		resp, err := c.Search("movies").SearchWithFallback(context.Background(), SearchRequest{Query: "prince"}, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, resp.Degraded)
+		assert.Equal(t, fallback, resp.Hits)
+	})
+
+	t.Run("aborted at the deadline", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		events := make(chan RequestEvent, 1)
+		c := newMockClient(t, Config{RequestObserver: func(event RequestEvent) { events <- event }},
+			func(w http.ResponseWriter, r *http.Request) {
+				<-release
+			})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		resp, err := c.Search("movies").SearchWithFallback(ctx, SearchRequest{Query: "prince"}, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, resp.Degraded)
+		select {
+		case event := <-events:
+			assert.True(t, errors.Is(event.Err, context.DeadlineExceeded), "%v should be the deadline", event.Err)
+		default:
+			t.Fatal("the search should be aborted when the fallback is returned")
+		}
+	})
+
+	t.Run("client timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		c := newMockClient(t, Config{Timeout: 20 * time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		})
+
+		resp, err := c.Search("movies").SearchWithFallback(context.Background(), SearchRequest{Query: "prince"}, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, resp.Degraded)
+		assert.Equal(t, fallback, resp.Hits)
+	})
+
+	t.Run("connection error", func(t *testing.T) {
+		c := NewClient(Config{Host: "http://127.0.0.1:1"})
+
+		resp, err := c.Search("movies").SearchWithFallback(context.Background(), SearchRequest{Query: "prince"}, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, resp.Degraded)
+	})
+
+	t.Run("client error is returned", func(t *testing.T) {
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index movies not found"}`))
+		})
+
+		_, err := c.Search("movies").SearchWithFallback(context.Background(), SearchRequest{Query: "prince"}, fallback)
+		if err == nil {
+			t.Fatal("a 404 should not fall back")
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"hits":[{"id":"2"}],"nbHits":1,"query":"prince"}`))
+		})
+
+		resp, err := c.Search("movies").SearchWithFallback(context.Background(), SearchRequest{Query: "prince"}, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, resp.Degraded)
+		assert.Equal(t, []interface{}{map[string]interface{}{"id": "2"}}, resp.Hits)
+	})
+}
+
+func TestClientSearch_CountOnly(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[],"query":"prince","processingTimeMs":1,"hitsPerPage":0,"page":1,"totalPages":0,"totalHits":42}`))
+	})
+
+	resp, err := c.Search("TestClientSearch_CountOnly").Search(SearchRequest{
+		Query:     "prince",
+		Offset:    10,
+		Limit:     5,
+		CountOnly: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"q":"prince","hitsPerPage":0}`, body)
+	assert.Equal(t, int64(42), resp.TotalHits)
+	assert.Empty(t, resp.Hits)
+}
+
+func TestClientSearch_ExtraQueryParams(t *testing.T) {
+	var query url.Values
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+	})
+
+	_, err := c.Search("TestClientSearch_ExtraQueryParams").Search(SearchRequest{
+		Query:            "prince",
+		ExtraQueryParams: map[string]string{"showRankingScore": "true", "experimental": "a b&c"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, url.Values{"showRankingScore": {"true"}, "experimental": {"a b&c"}}, query)
+	assert.JSONEq(t, `{"q":"prince"}`, body)
+}
+
+func TestClientSearch_NotDisplayedAttributes(t *testing.T) {
+	displayed := `["title","overview"]`
+	settingsCalls := 0
+	c := newMockClient(t, Config{SettingsCacheTTL: time.Hour}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /indexes/movies/settings":
+			settingsCalls++
+			_, _ = w.Write([]byte(`{"displayedAttributes":` + displayed + `}`))
+		case "POST /indexes/movies/settings/displayed-attributes", "DELETE /indexes/movies/settings/displayed-attributes":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	notDisplayed, err := c.Search("movies").NotDisplayedAttributes([]string{"title", "release_date", "overview", "poster"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"release_date", "poster"}, notDisplayed)
+
+	notDisplayed, err = c.Search("movies").NotDisplayedAttributes([]string{"title", "*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, notDisplayed)
+	assert.Equal(t, 1, settingsCalls, "the settings should be cached")
+
+	// changing the settings drops the cache
+	displayed = `["*"]`
+	if _, err := c.Settings("movies").UpdateDisplayedAttributes([]string{"*"}); err != nil {
+		t.Fatal(err)
+	}
+	notDisplayed, err = c.Search("movies").NotDisplayedAttributes([]string{"release_date"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, notDisplayed)
+	assert.Equal(t, 2, settingsCalls)
+
+	// every attribute is displayed when the setting is not set
+	displayed = `null`
+	if _, err := c.Settings("movies").ResetDisplayedAttributes(); err != nil {
+		t.Fatal(err)
+	}
+	notDisplayed, err = c.Search("movies").NotDisplayedAttributes([]string{"title", "release_date"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, notDisplayed)
+	assert.Equal(t, 3, settingsCalls)
+
+	_, err = c.Search("unknown").NotDisplayedAttributes([]string{"title"})
+	assert.Error(t, err)
+}
+
+func TestClientSearch_StableExport(t *testing.T) {
+	ids := map[int]bool{10: true, 20: true, 30: true, 40: true, 50: true, 60: true, 70: true}
+	inserted := []int{}
+	var bodies []map[string]interface{}
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		bodies = append(bodies, body)
+
+		after := -1
+		assert.Nil(t, body["filters"])
+		if filter := body["filter"].(string); filter != "genre = comedy" {
+			if _, err := fmt.Sscanf(filter, "(genre = comedy) AND id > %d", &after); err != nil {
+				t.Error(err)
+			}
+		}
+
+		sorted := []int{}
+		for id := range ids {
+			if id > after {
+				sorted = append(sorted, id)
+			}
+		}
+		sort.Ints(sorted)
+		if limit := int(body["limit"].(float64)); len(sorted) > limit {
+			sorted = sorted[:limit]
+		}
+
+		hits := []interface{}{}
+		for _, id := range sorted {
+			hits = append(hits, map[string]interface{}{"id": id})
+		}
+		data, _ := json.Marshal(map[string]interface{}{"hits": hits})
+		_, _ = w.Write(data)
+
+		// documents are added before and after the current page while exporting
+		if len(sorted) != 0 {
+			last := sorted[len(sorted)-1]
+			ids[last-5] = true
+			ids[last+5] = true
+			inserted = append(inserted, last+5)
+		}
+	})
+
+	var exported []int
+	err := c.Search("movies").StableExport(context.Background(), SearchRequest{
+		Filter: "genre = comedy",
+		Limit:  3,
+	}, "id", func(hits []interface{}) error {
+		for _, hit := range hits {
+			exported = append(exported, int(hit.(map[string]interface{})["id"].(float64)))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, sort.IntsAreSorted(exported))
+	seen := map[int]bool{}
+	for _, id := range exported {
+		assert.False(t, seen[id], "%d is exported twice", id)
+		seen[id] = true
+	}
+	for _, id := range append([]int{10, 20, 30, 40, 50, 60, 70}, inserted[:len(inserted)-1]...) {
+		assert.True(t, seen[id], "%d is missing", id)
+	}
+
+	assert.Equal(t, []interface{}{"id:asc"}, bodies[0]["sort"])
+	assert.Nil(t, bodies[0]["offset"])
+	assert.Equal(t, "(genre = comedy) AND id > 30", bodies[1]["filter"])
+}
+
+func TestClientSearch_StableExport_PrimaryKey(t *testing.T) {
+	var bodies []string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(raw))
+		switch {
+		case r.URL.Path == "/indexes/books/search":
+			_, _ = w.Write([]byte(`{"hits":[{"isbn":"978-0140449136"},{"isbn":"978-0451524935"}]}`))
+		case len(bodies) == 1:
+			_, _ = w.Write([]byte(`{"hits":[{"id":9007199254740992},{"id":9007199254740993}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"hits":[]}`))
+		}
+	})
+
+	err := c.Search("movies").StableExport(context.Background(), SearchRequest{Limit: 2}, "id",
+		func(hits []interface{}) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, bodies, 2)
+	assert.JSONEq(t, `{"q":"","limit":2,"sort":["id:asc"],"filter":"id > 9007199254740993"}`, bodies[1],
+		"the big integers should not be rounded")
+
+	bodies = nil
+	calls := 0
+	err = c.Search("books").StableExport(context.Background(), SearchRequest{Limit: 2}, "isbn",
+		func(hits []interface{}) error {
+			calls++
+			return nil
+		})
+	assert.EqualError(t, err, `StableExport requires a numeric primary key, "isbn" is "978-0451524935"`)
+	assert.Zero(t, calls, "the page should not be exported if the next one cannot be fetched")
+	assert.Len(t, bodies, 1)
+
+	bodies = nil
+	for _, request := range []SearchRequest{{Offset: 10}, {Filters: "genre = comedy"}} {
+		err = c.Search("movies").StableExport(context.Background(), request, "id",
+			func(hits []interface{}) error { return nil })
+		if assert.Error(t, err) {
+			assert.Equal(t, ErrCodeRequestValidation, err.(*Error).ErrCode)
+		}
+	}
+	assert.Empty(t, bodies, "an invalid request should not be sent")
+}
+
+func TestClientSearch_PlaceholderSearch(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+	})
+
+	tests := []struct {
+		name    string
+		request SearchRequest
+		want    string
+	}{
+		{name: "placeholder", request: SearchRequest{PlaceholderSearch: true}, want: `{"q":""}`},
+		{name: "placeholder ignores the query", request: SearchRequest{Query: "prince", PlaceholderSearch: true}, want: `{"q":""}`},
+		{name: "empty query", request: SearchRequest{}, want: `{"q":""}`},
+		{name: "query", request: SearchRequest{Query: "prince"}, want: `{"q":"prince"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.Search("TestClientSearch_PlaceholderSearch").Search(tt.request); err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tt.want, body)
+		})
+	}
+}
+
+func TestClientSearch_SemanticHitCount(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"hits":[{"id":1},{"id":2},{"id":3}],"query":"prince","processingTimeMs":3,` +
+			`"limit":20,"offset":0,"estimatedTotalHits":3,"semanticHitCount":2}`))
+	})
+
+	resp, err := c.Search("TestClientSearch_SemanticHitCount").Search(SearchRequest{Query: "prince"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(2), resp.SemanticHitCount)
+	assert.Len(t, resp.Hits, 3)
+}
+
+func TestClientSearch_RankingScoreThresholdFacets(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[{"book_id":456,"tag":"Tale"},{"book_id":4,"tag":"Novel"}],"nbHits":2,` +
+			`"offset":0,"limit":20,"processingTimeMs":1,"query":"prince",` +
+			`"facetsDistribution":{"tag":{"Tale":1,"Novel":1}},"exhaustiveFacetsCount":true}`))
+	})
+
+	threshold := 0.5
+	resp, err := c.Search("TestClientSearch_RankingScoreThresholdFacets").Search(SearchRequest{
+		Query:                 "prince",
+		RankingScoreThreshold: &threshold,
+		FacetsDistribution:    []string{"tag"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"q":"prince","rankingScoreThreshold":0.5,"facetsDistribution":["tag"]}`, body)
+	assert.True(t, resp.ExhaustiveFacetsCount)
+
+	counts, err := resp.FacetCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]map[string]int64{"tag": {"Tale": 1, "Novel": 1}}, counts)
+}
+
+func TestClientSearch_AttributesToRetrieve(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+	})
+
+	tests := []struct {
+		name                 string
+		attributesToRetrieve []string
+		expected             string
+	}{
+		{name: "nil", attributesToRetrieve: nil, expected: `{"q":"prince"}`},
+		{name: "empty", attributesToRetrieve: []string{}, expected: `{"q":"prince","attributesToRetrieve":[]}`},
+		{name: "populated", attributesToRetrieve: []string{"title"}, expected: `{"q":"prince","attributesToRetrieve":["title"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Search("TestClientSearch_AttributesToRetrieve").Search(SearchRequest{
+				Query:                "prince",
+				AttributesToRetrieve: tt.attributesToRetrieve,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tt.expected, body)
+		})
+	}
+}
+
+func TestClientSearch_SearchGet(t *testing.T) {
+	var method string
+	var body map[string]interface{}
+	var query url.Values
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		query = r.URL.Query()
+		body = nil
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		_, _ = w.Write([]byte(`{"hits":[{"id":"1"}],"nbHits":1,"offset":5,"limit":10,"query":"nestle"}`))
+	})
+
+	threshold := 0.5
+	request := SearchRequest{
+		Query:                 "nestle",
+		Offset:                5,
+		Limit:                 10,
+		AttributesToRetrieve:  []string{"id", "title"},
+		AttributesToHighlight: []string{"title"},
+		Filters:               "year > 1990",
+		Matches:               true,
+		FacetsDistribution:    []string{"genre"},
+		FacetFilters:          [][]string{{"genre:comedy", "genre:drama"}},
+		Sort:                  []string{"year:desc"},
+		RankingScoreThreshold: &threshold,
+		ExtraQueryParams:      map[string]string{"vector": "none"},
+	}
+
+	resp, err := c.Search("movies").SearchGet(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.MethodGet, method)
+	assert.Equal(t, int64(1), resp.NbHits)
+	assert.Equal(t, url.Values{
+		"q":                     {"nestle"},
+		"offset":                {"5"},
+		"limit":                 {"10"},
+		"attributesToRetrieve":  {"id,title"},
+		"attributesToHighlight": {"title"},
+		"filters":               {"year > 1990"},
+		"matches":               {"true"},
+		"facetsDistribution":    {"genre"},
+		"facetFilters":          {`[["genre:comedy","genre:drama"]]`},
+		"sort":                  {"year:desc"},
+		"rankingScoreThreshold": {"0.5"},
+		"vector":                {"none"},
+	}, query)
+
+	getQuery := query
+	if _, err := c.Search("movies").Search(request); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, http.MethodPost, method)
+	assert.Len(t, body, len(getQuery)-1, "the same parameters must be sent as with POST")
+	for key := range body {
+		assert.Contains(t, getQuery, key)
+	}
+
+	if _, err := c.Search("movies").SearchGet(SearchRequest{Query: "nestle"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, url.Values{"q": {"nestle"}}, query)
+
+	if _, err := c.Search("movies").SearchGet(SearchRequest{Query: "nestle", Filter: "year > 1990"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, url.Values{"q": {"nestle"}, "filter": {"year > 1990"}}, query)
+
+	threshold = 2
+	_, err = c.Search("movies").SearchGet(SearchRequest{RankingScoreThreshold: &threshold})
+	assert.Equal(t, ErrCodeRequestValidation, err.(*Error).ErrCode)
+}
+
+func TestParseSearchRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    SearchRequest
+		wantErr string
+	}{
+		{
+			name:  "all parameters",
+			query: "q=star+wars&limit=10&offset=20&filter=year+%3E+1990&sort=year:desc,+title:asc&facets=genre,director",
+			want: SearchRequest{
+				Query:              "star wars",
+				Limit:              10,
+				Offset:             20,
+				Filter:             "year > 1990",
+				Sort:               []string{"year:desc", "title:asc"},
+				FacetsDistribution: []string{"genre", "director"},
+			},
+		},
+		{
+			name:  "only a query",
+			query: "q=nestle&unknown=1",
+			want:  SearchRequest{Query: "nestle"},
+		},
+		{
+			name:  "empty lists",
+			query: "sort=&facets=,",
+			want:  SearchRequest{},
+		},
+		{
+			name:    "malformed limit",
+			query:   "q=nestle&limit=ten",
+			wantErr: `limit must be a non-negative integer, got "ten"`,
+		},
+		{
+			name:    "negative offset",
+			query:   "offset=-1",
+			wantErr: `offset must be a non-negative integer, got "-1"`,
+		},
+		{
+			name:    "overflowing limit",
+			query:   "limit=99999999999999999999",
+			wantErr: `limit must be a non-negative integer, got "99999999999999999999"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ParseSearchRequest(values)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseSearchRequest_RoundTrip(t *testing.T) {
+	var query url.Values
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+	})
+
+	values := url.Values{"q": {"star wars"}, "filter": {"year > 1990"}, "sort": {"year:desc"}}
+	request, err := ParseSearchRequest(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Search("movies").SearchGet(request); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, values, query, "the parameters should be sent as they were received")
+}
+
+func TestClientSearch_HighlightTags(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		_, _ = w.Write([]byte(`{"hits":[{"id":"1","_formatted":{"title":"The <mark>Little</mark> Prince"}}],"nbHits":1}`))
+	})
+
+	_, err := c.Search("movies").Search(SearchRequest{
+		Query:                 "little",
+		AttributesToHighlight: []string{"title"},
+		HighlightPreTag:       "<mark>",
+		HighlightPostTag:      "</mark>",
+		CropMarker:            "[…]",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"q":"little","attributesToHighlight":["title"],"highlightPreTag":"<mark>",`+
+		`"highlightPostTag":"</mark>","cropMarker":"[…]"}`, body)
+
+	if _, err := c.Search("movies").Search(SearchRequest{Query: "little"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"q":"little"}`, body, "the default tags should not be sent")
+}
+
+func TestClientSearch_ValidateFilters(t *testing.T) {
+	var searches int
+	settings := `{"filterableAttributes":["genre","year"]}`
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes/movies/settings" {
+			_, _ = w.Write([]byte(settings))
+			return
+		}
+		searches++
+		_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+	}
+
+	c := newMockClient(t, Config{ValidateFilters: true}, handler)
+	if _, err := c.Search("movies").Search(SearchRequest{Filter: "genre = comedy AND year > 1990"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, searches)
+
+	_, err := c.Search("movies").Search(SearchRequest{Filter: "genre = comedy AND (director = Nolan OR rating > 4)"})
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrCodeRequestValidation, err.(*Error).ErrCode)
+		assert.Contains(t, err.Error(), "attributes director, rating of the filter are not filterable")
+	}
+	_, err = c.Search("movies").SearchGet(SearchRequest{Filter: "director = Nolan"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, searches, "an invalid filter should not be sent")
+
+	// the legacy filters are not checked, the versions reading them accept any attribute
+	if _, err := c.Search("movies").Search(SearchRequest{Filters: "director = Nolan"}); err != nil {
+		t.Fatal(err)
+	}
+
+	settings = `{"attributesForFaceting":["director"]}`
+	c = newMockClient(t, Config{ValidateFilters: true}, handler)
+	_, err = c.Search("movies").Search(SearchRequest{Filter: "director = Nolan"})
+	assert.Error(t, err, "the attributes for faceting are not filterable")
+
+	c = newMockClient(t, Config{}, handler)
+	if _, err := c.Search("movies").Search(SearchRequest{Filter: "rating > 4"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, searches)
+}
+
+func TestClientSearch_ValidateFilters_UpdatedSettings(t *testing.T) {
+	var mu sync.Mutex
+	filterable := `["genre"]`
+	var settingsCalls, searches int
+	c := newMockClient(t, Config{ValidateFilters: true, SettingsCacheTTL: time.Hour},
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/indexes/movies/settings":
+				filterable = `["genre","year"]`
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"updateId":1}`))
+			case r.URL.Path == "/indexes/movies/updates/1":
+				_, _ = w.Write([]byte(`{"status":"processed","updateId":1}`))
+			case r.URL.Path == "/indexes/movies/settings":
+				settingsCalls++
+				_, _ = w.Write([]byte(`{"filterableAttributes":` + filterable + `}`))
+			default:
+				searches++
+				_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+			}
+		})
+
+	if _, err := c.Search("movies").Search(SearchRequest{Filter: "genre = comedy"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Settings("movies").UpdateAll(Settings{FilterableAttributes: []string{"genre", "year"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Search("movies").Search(SearchRequest{Filter: "year > 1990"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// changed by another client, the cached settings are stale
+	mu.Lock()
+	filterable = `["genre","year","director"]`
+	mu.Unlock()
+	if _, err := c.Search("movies").Search(SearchRequest{Filter: "director = Nolan"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, searches)
+
+	settingsCalls = 0
+	_, err := c.Search("movies").Search(SearchRequest{Filter: "rating > 4"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, settingsCalls, "the settings should be fetched again once before rejecting the filter")
+	assert.Equal(t, 3, searches)
+}
+
+func TestClientSearch_SearchRaw(t *testing.T) {
+	const response = `{"query":"nestle","hits":[{"title":"Nestle","id":"1"}],"nbHits":1,"offset":0,"limit":20}`
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/indexes/movies/search" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index missing not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	})
+
+	raw, err := c.Search("movies").SearchRaw(SearchRequest{Query: "nestle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, response, string(raw), "the response should be returned untouched")
+
+	_, err = c.Search("missing").SearchRaw(SearchRequest{Query: "nestle"})
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*Error).StatusCode)
+	}
+}
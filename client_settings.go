@@ -1,9 +1,59 @@
 package meilisearch
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
 	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
+var (
+	// settingsEndpoints maps the name of each setting to its endpoint
+	settingsEndpoints = map[string]string{
+		"rankingRules":          "ranking-rules",
+		"distinctAttribute":     "distinct-attribute",
+		"searchableAttributes":  "searchable-attributes",
+		"displayedAttributes":   "displayed-attributes",
+		"stopWords":             "stop-words",
+		"synonyms":              "synonyms",
+		"attributesForFaceting": "attributes-for-faceting",
+		"sortableAttributes":    "sortable-attributes",
+		"pagination":            "pagination",
+		"typoTolerance":         "typo-tolerance",
+		"faceting":              "faceting",
+	}
+
+	builtinRankingRules = map[string]bool{
+		"words":         true,
+		"typo":          true,
+		"proximity":     true,
+		"attribute":     true,
+		"sort":          true,
+		"exactness":     true,
+		"wordsPosition": true,
+	}
+
+	// customRankingRule matches attr:asc and attr:desc, and the legacy asc(attr) and desc(attr) forms.
+	customRankingRule = regexp.MustCompile(`^([^\s:()]+:(asc|desc)|(asc|desc)\([^\s:()]+\))$`)
+)
+
+// ValidateRankingRules checks that each rule is either a built-in ranking rule or a custom ascending or
+// descending rule on an attribute. It returns an error naming the first invalid rule.
+func ValidateRankingRules(rules []string) error {
+	for _, rule := range rules {
+		if !builtinRankingRules[rule] && !customRankingRule.MatchString(rule) {
+			return errors.Errorf("invalid ranking rule %q", rule)
+		}
+	}
+	return nil
+}
+
 type clientSettings struct {
 	client   *Client
 	indexUID string
@@ -13,6 +63,100 @@ func newClientSettings(client *Client, indexUID string) clientSettings {
 	return clientSettings{client: client, indexUID: indexUID}
 }
 
+// settingsCache holds the settings fetched by Client.cachedSettings, by index uid.
+type settingsCache struct {
+	mu      sync.Mutex
+	entries map[string]settingsCacheEntry
+}
+
+// settingsCacheEntry holds the settings of an index as JSON, so that each caller decodes its own copy. While
+// pending is set, the settings are being changed by this update and are not cached.
+type settingsCacheEntry struct {
+	data      []byte
+	expiresAt time.Time
+	pending   *AsyncUpdateID
+	// version changes each time the settings are changed, so that settings fetched before are not cached
+	version int
+}
+
+func newSettingsCache() *settingsCache {
+	return &settingsCache{entries: map[string]settingsCacheEntry{}}
+}
+
+// get returns a copy of the cached settings of an index, or nil along with the update changing them, if any, and
+// the version to give to set.
+func (c *settingsCache) get(indexUID string) (settings *Settings, pending *AsyncUpdateID, version int) {
+	c.mu.Lock()
+	entry := c.entries[indexUID]
+	c.mu.Unlock()
+
+	if entry.data == nil || time.Now().After(entry.expiresAt) {
+		return nil, entry.pending, entry.version
+	}
+	settings = &Settings{}
+	if err := settings.UnmarshalJSON(entry.data); err != nil {
+		return nil, entry.pending, entry.version
+	}
+	return settings, nil, entry.version
+}
+
+// set caches settings, unless they have been changed since version or an update is changing them.
+func (c *settingsCache) set(indexUID string, settings *Settings, version int, ttl time.Duration) {
+	data, err := settings.MarshalJSON()
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry := c.entries[indexUID]; entry.version == version && entry.pending == nil {
+		c.entries[indexUID] = settingsCacheEntry{data: data, expiresAt: time.Now().Add(ttl), version: version}
+	}
+}
+
+// invalidate drops the cached settings of an index, which are not cached again until pending, the update
+// changing them, if any, is processed.
+func (c *settingsCache) invalidate(indexUID string, pending *AsyncUpdateID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[indexUID] = settingsCacheEntry{pending: pending, version: c.entries[indexUID].version + 1}
+}
+
+// drop drops the cached settings of an index, e.g. because they may be stale, any pending update being kept.
+func (c *settingsCache) drop(indexUID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := c.entries[indexUID]
+	c.entries[indexUID] = settingsCacheEntry{pending: entry.pending, version: entry.version + 1}
+}
+
+// settle records that pending, given by get at version, has been processed.
+func (c *settingsCache) settle(indexUID string, pending *AsyncUpdateID, version int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry := c.entries[indexUID]; entry.version == version && entry.pending == pending {
+		entry.pending = nil
+		c.entries[indexUID] = entry
+	}
+}
+
+// executeRequest executes req and drops the cached settings of the index when req changes them. As the changes
+// are asynchronous, the settings are not cached again until the update is processed.
+func (c clientSettings) executeRequest(req internalRequest) error {
+	err := c.client.executeRequest(req)
+	if req.method != http.MethodGet {
+		updateID, _ := req.withResponse.(*AsyncUpdateID)
+		if err != nil {
+			updateID = nil
+		}
+		c.client.settingsCache.invalidate(c.indexUID, updateID)
+	}
+	return err
+}
+
 func (c clientSettings) IndexID() string {
 	return c.indexUID
 }
@@ -33,25 +177,53 @@ func (c clientSettings) GetAll() (resp *Settings, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+func (c clientSettings) GetRaw(setting string) (json.RawMessage, error) {
+	var resp RawType
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/" + settingsEndpoints[setting],
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        &resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetRaw",
+		apiName:             "Settings",
+	}
+
+	if _, ok := settingsEndpoints[setting]; !ok {
+		return nil, newError(req).WithErrCode(ErrCodeRequestValidation, errors.Errorf("unknown setting %q", setting))
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(resp), nil
+}
+
 func (c clientSettings) UpdateAll(request Settings) (resp *AsyncUpdateID, err error) {
 	resp = &AsyncUpdateID{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + c.indexUID + "/settings",
 		method:              http.MethodPost,
-		withRequest:         &request,
+		withRequest:         settingsUpdate{request},
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateAll",
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if c.client.config.ValidateRankingRules {
+		if err := ValidateRankingRules(request.RankingRules); err != nil {
+			return nil, newError(req).WithErrCode(ErrCodeRequestValidation, err)
+		}
+	}
+
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -70,7 +242,7 @@ func (c clientSettings) ResetAll() (resp *AsyncUpdateID, err error) {
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -89,7 +261,7 @@ func (c clientSettings) GetRankingRules() (resp *[]string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -107,7 +279,13 @@ func (c clientSettings) UpdateRankingRules(request []string) (resp *AsyncUpdateI
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if c.client.config.ValidateRankingRules {
+		if err := ValidateRankingRules(request); err != nil {
+			return nil, newError(req).WithErrCode(ErrCodeRequestValidation, err)
+		}
+	}
+
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -126,7 +304,7 @@ func (c clientSettings) ResetRankingRules() (resp *AsyncUpdateID, err error) {
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -146,7 +324,7 @@ func (c clientSettings) GetDistinctAttribute() (resp *string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -164,7 +342,7 @@ func (c clientSettings) UpdateDistinctAttribute(request string) (resp *AsyncUpda
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -183,7 +361,7 @@ func (c clientSettings) ResetDistinctAttribute() (resp *AsyncUpdateID, err error
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -202,7 +380,7 @@ func (c clientSettings) GetSearchableAttributes() (resp *[]string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -220,7 +398,7 @@ func (c clientSettings) UpdateSearchableAttributes(request []string) (resp *Asyn
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -239,7 +417,7 @@ func (c clientSettings) ResetSearchableAttributes() (resp *AsyncUpdateID, err er
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -258,7 +436,7 @@ func (c clientSettings) GetDisplayedAttributes() (resp *[]string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -276,7 +454,7 @@ func (c clientSettings) UpdateDisplayedAttributes(request []string) (resp *Async
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -295,7 +473,7 @@ func (c clientSettings) ResetDisplayedAttributes() (resp *AsyncUpdateID, err err
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -314,7 +492,7 @@ func (c clientSettings) GetStopWords() (resp *[]string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -332,7 +510,7 @@ func (c clientSettings) UpdateStopWords(request []string) (resp *AsyncUpdateID,
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -351,7 +529,7 @@ func (c clientSettings) ResetStopWords() (resp *AsyncUpdateID, err error) {
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -370,7 +548,7 @@ func (c clientSettings) GetSynonyms() (resp *map[string][]string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -388,13 +566,80 @@ func (c clientSettings) UpdateSynonyms(request map[string][]string) (resp *Async
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+func (c clientSettings) ImportSynonymsFromReader(r io.Reader, format SynonymFormat) (resp *AsyncUpdateID, err error) {
+	synonyms, err := parseSynonyms(r, format)
+	if err != nil {
+		req := internalRequest{
+			endpoint:            "/indexes/" + c.indexUID + "/settings/synonyms",
+			method:              http.MethodPost,
+			acceptedStatusCodes: []int{http.StatusAccepted},
+			functionName:        "ImportSynonymsFromReader",
+			apiName:             "Documents",
+		}
+		return nil, newError(req).WithErrCode(ErrCodeRequestValidation, err)
+	}
+
+	return c.UpdateSynonyms(synonyms)
+}
+
+// parseSynonyms reads one group of synonyms per row, each term of a group being a synonym of all the others.
+// Empty cells are ignored and a term found in several groups gets the synonyms of all of them.
+func parseSynonyms(r io.Reader, format SynonymFormat) (map[string][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	switch format {
+	case SynonymFormatCSV:
+		reader.Comma = ','
+	case SynonymFormatTSV:
+		reader.Comma = '\t'
+	default:
+		return nil, errors.Errorf("unknown synonym format %d", format)
+	}
+
+	synonyms := map[string][]string{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return synonyms, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		group := make([]string, 0, len(record))
+		for _, term := range record {
+			if term = strings.TrimSpace(term); term != "" {
+				group = append(group, term)
+			}
+		}
+
+		for _, term := range group {
+			for _, synonym := range group {
+				if synonym != term && !containsString(synonyms[term], synonym) {
+					synonyms[term] = append(synonyms[term], synonym)
+				}
+			}
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func (c clientSettings) ResetSynonyms() (resp *AsyncUpdateID, err error) {
 	resp = &AsyncUpdateID{}
 	req := internalRequest{
@@ -407,7 +652,7 @@ func (c clientSettings) ResetSynonyms() (resp *AsyncUpdateID, err error) {
 		apiName:             "Documents",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -426,7 +671,7 @@ func (c clientSettings) GetAttributesForFaceting() (resp *[]string, err error) {
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -444,7 +689,7 @@ func (c clientSettings) UpdateAttributesForFaceting(request []string) (resp *Asy
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
@@ -463,9 +708,317 @@ func (c clientSettings) ResetAttributesForFaceting() (resp *AsyncUpdateID, err e
 		apiName:             "Settings",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) GetSortableAttributes() (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/sortable-attributes",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetSortableAttributes",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientSettings) UpdateSortableAttributes(request []string) (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/sortable-attributes",
+		method:              http.MethodPost,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateSortableAttributes",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) ResetSortableAttributes() (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/sortable-attributes",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetSortableAttributes",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) GetPagination() (resp *Pagination, err error) {
+	resp = &Pagination{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/pagination",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetPagination",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientSettings) UpdatePagination(request Pagination) (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/pagination",
+		method:              http.MethodPost,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdatePagination",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) ResetPagination() (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/pagination",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetPagination",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) GetTypoTolerance() (resp *TypoTolerance, err error) {
+	resp = &TypoTolerance{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/typo-tolerance",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetTypoTolerance",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientSettings) UpdateTypoTolerance(request TypoTolerance) (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/typo-tolerance",
+		method:              http.MethodPost,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateTypoTolerance",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) ResetTypoTolerance() (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/typo-tolerance",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetTypoTolerance",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) GetFaceting() (resp *Faceting, err error) {
+	resp = &Faceting{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/faceting",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetFaceting",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c clientSettings) UpdateFaceting(request Faceting) (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/faceting",
+		method:              http.MethodPost,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateFaceting",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c clientSettings) ResetFaceting() (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings/faceting",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetFaceting",
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
 		return nil, err
 	}
 
 	return resp, nil
 }
+
+func (c clientSettings) UpdateWithRollback(update func(settings APISettings) error) error {
+	snapshot, err := newClientSettings(c.client.onPrimary(), c.indexUID).getAllRaw("UpdateWithRollback")
+	if err != nil {
+		return err
+	}
+
+	if err := update(c); err != nil {
+		_, rollbackErr := c.updateAllRaw(snapshot, "UpdateWithRollback")
+		return &RollbackError{Err: err, RollbackErr: rollbackErr}
+	}
+	return nil
+}
+
+// getAllRaw gets all the settings as sent by MeiliSearch, each setting being set, the empty ones included.
+func (c clientSettings) getAllRaw(functionName string) (resp RawType, err error) {
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        &resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        functionName,
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// updateAllRaw applies settings read with getAllRaw in a single update. As each setting is set, the current
+// settings are all replaced, the ones which were empty being emptied.
+func (c clientSettings) updateAllRaw(settings RawType, functionName string) (resp *AsyncUpdateID, err error) {
+	resp = &AsyncUpdateID{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + c.indexUID + "/settings",
+		method:              http.MethodPost,
+		withRequest:         settings,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        functionName,
+		apiName:             "Settings",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// settingsUpdate is the body of UpdateAll. A nil DistinctAttribute is omitted so that it is left unchanged, a
+// pointer to "" is sent as null to reset it and any other value sets it.
+type settingsUpdate struct {
+	Settings
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (s settingsUpdate) MarshalJSON() ([]byte, error) {
+	distinctAttribute := s.DistinctAttribute
+	s.DistinctAttribute = nil
+
+	data, err := s.Settings.MarshalJSON()
+	if err != nil || distinctAttribute == nil {
+		return data, err
+	}
+
+	field := []byte(`"distinctAttribute":null`)
+	if *distinctAttribute != "" {
+		value, err := json.Marshal(*distinctAttribute)
+		if err != nil {
+			return nil, err
+		}
+		field = append([]byte(`"distinctAttribute":`), value...)
+	}
+
+	// data is at least {}, the field is added first
+	body := append([]byte{'{'}, field...)
+	if len(data) > 2 {
+		body = append(body, ',')
+	}
+	return append(body, data[1:]...), nil
+}
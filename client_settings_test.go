@@ -1,9 +1,14 @@
 package meilisearch
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -629,3 +634,412 @@ func TestClientSettings_ResetAttributesForFaceting(t *testing.T) {
 		t.Fatal("resetAttributesForFaceting: Error getting attributesForFaceting after reset")
 	}
 }
+
+func TestValidateRankingRules(t *testing.T) {
+	valid := [][]string{
+		{"words", "typo", "proximity", "attribute", "sort", "exactness"},
+		{"typo", "words", "proximity", "attribute", "wordsPosition", "exactness"},
+		{"words", "release_date:desc", "price:asc", "rank.score:desc"},
+		{"typo", "asc(price)", "desc(release_date)"},
+		nil,
+	}
+	for _, rules := range valid {
+		if err := ValidateRankingRules(rules); err != nil {
+			t.Errorf("%v should be valid: %v", rules, err)
+		}
+	}
+
+	invalid := [][]string{
+		{"word", "typo"},
+		{"Typo"},
+		{"price:ascending"},
+		{":asc"},
+		{"release date:desc"},
+		{"asc(price"},
+		{""},
+	}
+	for _, rules := range invalid {
+		if err := ValidateRankingRules(rules); err == nil {
+			t.Errorf("%v should be invalid", rules)
+		}
+	}
+}
+
+func TestClientSettings_ValidateRankingRules(t *testing.T) {
+	var requests int
+	c := newMockClient(t, Config{ValidateRankingRules: true}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	_, err := c.Settings("TestClientSettings_ValidateRankingRules").UpdateRankingRules([]string{"word", "typo"})
+	if err == nil || err.(*Error).ErrCode != ErrCodeRequestValidation {
+		t.Fatalf("a typo should be rejected, got %v", err)
+	}
+	_, err = c.Settings("TestClientSettings_ValidateRankingRules").UpdateAll(Settings{RankingRules: []string{"proximty"}})
+	if err == nil || err.(*Error).ErrCode != ErrCodeRequestValidation {
+		t.Fatalf("a typo should be rejected, got %v", err)
+	}
+	assert.Equal(t, 0, requests)
+
+	_, err = c.Settings("TestClientSettings_ValidateRankingRules").UpdateRankingRules([]string{"words", "price:asc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func TestClientSettings_UpdateAll_DistinctAttribute(t *testing.T) {
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	empty, movieID := "", "movie_id"
+	tests := []struct {
+		name     string
+		settings Settings
+		want     string
+	}{
+		{name: "nil is omitted", settings: Settings{StopWords: []string{"the"}}, want: `{"stopWords":["the"]}`},
+		{name: "empty string is reset", settings: Settings{DistinctAttribute: &empty}, want: `{"distinctAttribute":null}`},
+		{
+			name:     "value is set",
+			settings: Settings{DistinctAttribute: &movieID, StopWords: []string{"the"}},
+			want:     `{"distinctAttribute":"movie_id","stopWords":["the"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.Settings("movies").UpdateAll(tt.settings); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, body)
+		})
+	}
+}
+
+func TestClientSettings_UpdateWithRollback(t *testing.T) {
+	var calls []string
+	var restored string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		calls = append(calls, call)
+		switch call {
+		case "GET /indexes/movies/settings":
+			_, _ = w.Write([]byte(`{"rankingRules":["typo","words"],"distinctAttribute":null,"searchableAttributes":["title"],"displayedAttributes":["*"],"stopWords":["the"],"synonyms":{},"attributesForFaceting":[]}`))
+		case "POST /indexes/movies/settings/stop-words":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+		case "POST /indexes/movies/settings":
+			body, _ := ioutil.ReadAll(r.Body)
+			restored = string(body)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":3}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Invalid ranking rule"}`))
+		}
+	})
+
+	err := c.Settings("movies").UpdateWithRollback(func(settings APISettings) error {
+		if _, err := settings.UpdateStopWords([]string{"a", "an"}); err != nil {
+			return err
+		}
+		_, err := settings.UpdateRankingRules([]string{"wrong"})
+		return err
+	})
+
+	rollbackErr, ok := err.(*RollbackError)
+	if !ok {
+		t.Fatalf("expected a *RollbackError, got %v", err)
+	}
+	assert.Equal(t, http.StatusBadRequest, rollbackErr.Err.(*Error).StatusCode)
+	assert.NoError(t, rollbackErr.RollbackErr)
+	var updateErr *Error
+	if assert.True(t, errors.As(err, &updateErr), "the error of the update should be reachable") {
+		assert.Equal(t, "Invalid ranking rule", updateErr.MeilisearchMessage)
+	}
+	assert.Equal(t, []string{
+		"GET /indexes/movies/settings",
+		"POST /indexes/movies/settings/stop-words",
+		"POST /indexes/movies/settings/ranking-rules",
+		"POST /indexes/movies/settings",
+	}, calls, "the settings should be restored in a single update")
+	assert.JSONEq(t, `{"rankingRules":["typo","words"],"distinctAttribute":null,"searchableAttributes":["title"],"displayedAttributes":["*"],"stopWords":["the"],"synonyms":{},"attributesForFaceting":[]}`, restored,
+		"every setting should be restored, the empty ones included")
+
+	calls = nil
+	err = c.Settings("movies").UpdateWithRollback(func(settings APISettings) error {
+		_, err := settings.UpdateStopWords([]string{"a", "an"})
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET /indexes/movies/settings", "POST /indexes/movies/settings/stop-words"}, calls)
+}
+
+func TestClientSettings_ImportSynonymsFromReader(t *testing.T) {
+	var body map[string][]string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/indexes/movies/settings/synonyms" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body = nil
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	expected := map[string][]string{
+		"wolverine":   {"xmen", "logan"},
+		"xmen":        {"wolverine", "logan"},
+		"logan":       {"wolverine", "xmen", "old man"},
+		"old man":     {"logan"},
+		"batman":      {"bruce wayne"},
+		"bruce wayne": {"batman"},
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		format SynonymFormat
+	}{
+		{name: "csv", input: "wolverine, xmen, logan\nbatman,bruce wayne,\n\nlogan,old man\n", format: SynonymFormatCSV},
+		{name: "tsv", input: "wolverine\txmen\tlogan\nbatman\tbruce wayne\nlogan\t old man\n", format: SynonymFormatTSV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := c.Settings("movies").ImportSynonymsFromReader(strings.NewReader(tt.input), tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, int64(1), resp.UpdateID)
+			assert.Equal(t, expected, body)
+		})
+	}
+
+	_, err := c.Settings("movies").ImportSynonymsFromReader(strings.NewReader("a,\"b\nc"), SynonymFormatCSV)
+	if err == nil || err.(*Error).ErrCode != ErrCodeRequestValidation {
+		t.Fatalf("a malformed file should be rejected, got %v", err)
+	}
+}
+
+func TestClientSettings_GetRaw(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/settings/ranking-rules":
+			_, _ = w.Write([]byte(`["typo", "words", "desc(release_date)"]`))
+		case "/indexes/movies/settings/distinct-attribute":
+			_, _ = w.Write([]byte(`null`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	raw, err := c.Settings("movies").GetRaw("rankingRules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `["typo", "words", "desc(release_date)"]`, string(raw))
+
+	raw, err = c.Settings("movies").GetRaw("distinctAttribute")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `null`, string(raw))
+
+	_, err = c.Settings("movies").GetRaw("ranking-rules")
+	if err == nil || err.(*Error).ErrCode != ErrCodeRequestValidation {
+		t.Fatalf("an unknown setting should be rejected, got %v", err)
+	}
+
+	_, err = c.Settings("unknown").GetRaw("rankingRules")
+	if assert.Error(t, err) {
+		assert.Equal(t, "Settings", err.(*Error).APIName)
+	}
+}
+
+func TestClientSettings_Pagination(t *testing.T) {
+	var calls []string
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"maxTotalHits":1000}`))
+			return
+		}
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	pagination, err := c.Settings("movies").GetPagination()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &Pagination{MaxTotalHits: 1000}, pagination)
+
+	if _, err := c.Settings("movies").UpdatePagination(Pagination{MaxTotalHits: 5000}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"maxTotalHits":5000}`, body)
+
+	if _, err := c.Settings("movies").ResetPagination(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{
+		"GET /indexes/movies/settings/pagination",
+		"POST /indexes/movies/settings/pagination",
+		"DELETE /indexes/movies/settings/pagination",
+	}, calls)
+}
+
+func TestClientSettings_SortableAttributes(t *testing.T) {
+	var calls []string
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`["price","release_date"]`))
+			return
+		}
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	sortableAttributes, err := c.Settings("movies").GetSortableAttributes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &[]string{"price", "release_date"}, sortableAttributes)
+
+	if _, err := c.Settings("movies").UpdateSortableAttributes([]string{"price"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `["price"]`, body)
+
+	if _, err := c.Settings("movies").ResetSortableAttributes(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Settings("movies").UpdateAll(Settings{SortableAttributes: []string{"title"}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"sortableAttributes":["title"]}`, body)
+	assert.Equal(t, []string{
+		"GET /indexes/movies/settings/sortable-attributes",
+		"POST /indexes/movies/settings/sortable-attributes",
+		"DELETE /indexes/movies/settings/sortable-attributes",
+		"POST /indexes/movies/settings",
+	}, calls)
+}
+
+func TestClientSettings_TypoTolerance(t *testing.T) {
+	var calls []string
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"enabled":true,"minWordSizeForTypos":{"oneTypo":5,"twoTypos":9},"disableOnWords":[],"disableOnAttributes":["sku"]}`))
+			return
+		}
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	typoTolerance, err := c.Settings("products").GetTypoTolerance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := true
+	assert.Equal(t, &TypoTolerance{
+		Enabled:             &enabled,
+		MinWordSizeForTypos: &MinWordSizeForTypos{OneTypo: 5, TwoTypos: 9},
+		DisableOnWords:      &[]string{},
+		DisableOnAttributes: &[]string{"sku"},
+	}, typoTolerance)
+
+	if _, err := c.Settings("products").UpdateTypoTolerance(TypoTolerance{DisableOnAttributes: &[]string{"sku", "ean"}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"disableOnAttributes":["sku","ean"]}`, body)
+
+	if _, err := c.Settings("products").UpdateTypoTolerance(TypoTolerance{DisableOnAttributes: &[]string{}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"disableOnAttributes":[]}`, body, "an empty list should be sent to clear it")
+
+	disabled := false
+	if _, err := c.Settings("products").UpdateTypoTolerance(TypoTolerance{Enabled: &disabled}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"enabled":false}`, body)
+
+	if _, err := c.Settings("products").ResetTypoTolerance(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Settings("products").UpdateAll(Settings{TypoTolerance: &TypoTolerance{DisableOnWords: &[]string{"iphone"}}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"typoTolerance":{"disableOnWords":["iphone"]}}`, body)
+	assert.Equal(t, []string{
+		"GET /indexes/products/settings/typo-tolerance",
+		"POST /indexes/products/settings/typo-tolerance",
+		"POST /indexes/products/settings/typo-tolerance",
+		"POST /indexes/products/settings/typo-tolerance",
+		"DELETE /indexes/products/settings/typo-tolerance",
+		"POST /indexes/products/settings",
+	}, calls)
+}
+
+func TestClientSettings_Faceting(t *testing.T) {
+	var calls []string
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"maxValuesPerFacet":100}`))
+			return
+		}
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	faceting, err := c.Settings("products").GetFaceting()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &Faceting{MaxValuesPerFacet: 100}, faceting)
+
+	if _, err := c.Settings("products").UpdateFaceting(Faceting{MaxValuesPerFacet: 500}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"maxValuesPerFacet":500}`, body)
+
+	if _, err := c.Settings("products").ResetFaceting(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Settings("products").UpdateAll(Settings{Faceting: &Faceting{MaxValuesPerFacet: 1000}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"faceting":{"maxValuesPerFacet":1000}}`, body)
+	assert.Equal(t, []string{
+		"GET /indexes/products/settings/faceting",
+		"POST /indexes/products/settings/faceting",
+		"DELETE /indexes/products/settings/faceting",
+		"POST /indexes/products/settings",
+	}, calls)
+}
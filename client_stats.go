@@ -1,6 +1,15 @@
 package meilisearch
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// statsManyConcurrency is the maximum number of stats requests IndexStatsMany sends at the same time.
+const statsManyConcurrency = 4
 
 type clientStats struct {
 	client *Client
@@ -11,6 +20,10 @@ func newClientStats(client *Client) clientStats {
 }
 
 func (c clientStats) Get(indexUID string) (resp *StatsIndex, err error) {
+	return c.get(context.Background(), indexUID)
+}
+
+func (c clientStats) get(ctx context.Context, indexUID string) (resp *StatsIndex, err error) {
 	resp = &StatsIndex{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + indexUID + "/stats",
@@ -22,7 +35,7 @@ func (c clientStats) Get(indexUID string) (resp *StatsIndex, err error) {
 		apiName:             "Stats",
 	}
 
-	if err := c.client.executeRequest(req); err != nil {
+	if err := c.client.executeRequestContext(ctx, req); err != nil {
 		return nil, err
 	}
 
@@ -47,3 +60,121 @@ func (c clientStats) GetAll() (resp *Stats, err error) {
 
 	return resp, nil
 }
+
+func (c clientStats) TotalDocuments() (int64, error) {
+	stats, err := c.GetAll()
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, index := range stats.Indexes {
+		total += index.NumberOfDocuments
+	}
+	return total, nil
+}
+
+func (c clientStats) IndexStatsMany(uids []string) (map[string]*StatsIndex, error) {
+	return c.IndexStatsManyWithContext(context.Background(), uids)
+}
+
+func (c clientStats) IndexStatsManyWithContext(ctx context.Context, uids []string) (map[string]*StatsIndex, error) {
+	type result struct {
+		uid   string
+		stats *StatsIndex
+		err   error
+	}
+
+	budget := NewRequestBudget(ctx)
+	jobs := make(chan string)
+	results := make(chan result)
+
+	workers := statsManyConcurrency
+	if len(uids) < workers {
+		workers = len(uids)
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for uid := range jobs {
+				r := result{uid: uid}
+				subCtx, cancel, err := budget.Next()
+				if err != nil {
+					r.err = err
+				} else {
+					r.stats, r.err = c.get(subCtx, uid)
+					cancel()
+				}
+				results <- r
+			}
+		}()
+	}
+
+	go func() {
+		for _, uid := range uids {
+			jobs <- uid
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	resp := make(map[string]*StatsIndex, len(uids))
+	errs := IndexErrors{}
+	for r := range results {
+		if r.err != nil {
+			errs[r.uid] = r.err
+			continue
+		}
+		resp[r.uid] = r.stats
+	}
+
+	if len(errs) > 0 {
+		return resp, errs
+	}
+	return resp, nil
+}
+
+func (c clientStats) WatchStats(ctx context.Context, interval time.Duration) (<-chan Stats, <-chan error) {
+	statsChan := make(chan Stats)
+	errChan := make(chan error)
+
+	go func() {
+		defer close(statsChan)
+		defer close(errChan)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var last *Stats
+		for {
+			stats, err := c.GetAll()
+			switch {
+			case err != nil:
+				select {
+				case errChan <- err:
+				case <-ctx.Done():
+					return
+				}
+			case last == nil || !reflect.DeepEqual(*last, *stats):
+				select {
+				case statsChan <- *stats:
+					last = stats
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return statsChan, errChan
+}
@@ -1,7 +1,15 @@
 package meilisearch
 
 import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientStats_Get(t *testing.T) {
@@ -21,3 +29,206 @@ func TestClientStats_Get(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClientStats_GetIndex(t *testing.T) {
+	var path string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(`{"numberOfDocuments":19654,"isIndexing":true,` +
+			`"fieldsFrequency":{"poster":19654,"release_date":19654,"title":19654,"id":19654,"overview":19654}}`))
+	})
+
+	stats, err := c.Stats().Get("movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/indexes/movies/stats", path)
+	assert.Equal(t, &StatsIndex{
+		NumberOfDocuments: 19654,
+		IsIndexing:        true,
+		FieldsFrequency: map[string]int64{
+			"poster": 19654, "release_date": 19654, "title": 19654, "id": 19654, "overview": 19654,
+		},
+	}, stats)
+}
+
+func TestClientStats_TotalDocuments(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"databaseSize":447819776,"lastUpdate":"2019-11-15T11:15:22.092896Z","indexes":{` +
+			`"movies":{"numberOfDocuments":19654,"isIndexing":false,"fieldsFrequency":{"title":19654}},` +
+			`"books":{"numberOfDocuments":5,"isIndexing":true,"fieldsFrequency":{"title":5}},` +
+			`"empty":{"numberOfDocuments":0,"isIndexing":false,"fieldsFrequency":{}}}}`))
+	})
+
+	total, err := c.Stats().TotalDocuments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(19659), total)
+}
+
+func TestClientStats_LastUpdatePerIndex(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes/movies/stats" {
+			_, _ = w.Write([]byte(`{"numberOfDocuments":19654,"isIndexing":false,"fieldDistribution":{"title":19654},` +
+				`"lastUpdate":"2021-06-01T10:00:00Z","rawDocumentDbSize":1048576}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"databaseSize":447819776,"lastUpdate":"2021-06-02T10:00:00Z","indexes":{` +
+			`"movies":{"numberOfDocuments":19654,"isIndexing":false,"lastUpdate":"2021-06-01T10:00:00Z","rawDocumentDbSize":1048576},` +
+			`"books":{"numberOfDocuments":5,"isIndexing":false}}}`))
+	})
+
+	lastUpdate := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	stats, err := c.Stats().Get("movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, lastUpdate.Equal(*stats.LastUpdate))
+	assert.Equal(t, int64(1048576), stats.RawDocumentDbSize)
+	assert.Equal(t, map[string]int64{"title": 19654}, stats.FieldDistribution)
+
+	all, err := c.Stats().GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, lastUpdate.Equal(*all.Indexes["movies"].LastUpdate))
+	assert.Equal(t, int64(1048576), all.Indexes["movies"].RawDocumentDbSize)
+	assert.Nil(t, all.Indexes["books"].LastUpdate, "older servers report no last update per index")
+	assert.Zero(t, all.Indexes["books"].RawDocumentDbSize)
+}
+
+func TestClientStats_IndexStatsMany(t *testing.T) {
+	var mu sync.Mutex
+	var running, maxRunning int
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		uid := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/indexes/"), "/stats")
+		if uid == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index missing not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"numberOfDocuments":` + strconv.Itoa(len(uid)) + `,"isIndexing":false}`))
+	})
+
+	uids := []string{"a", "bb", "ccc", "dddd", "eeeee", "ffffff", "ggggggg", "hhhhhhhh"}
+	resp, err := c.Stats().IndexStatsMany(uids)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, resp, len(uids))
+	for _, uid := range uids {
+		assert.Equal(t, int64(len(uid)), resp[uid].NumberOfDocuments)
+	}
+	assert.Greater(t, maxRunning, 1)
+	assert.LessOrEqual(t, maxRunning, statsManyConcurrency)
+
+	resp, err = c.Stats().IndexStatsMany([]string{"a", "missing"})
+	if err == nil {
+		t.Fatal("the missing index should fail")
+	}
+	errs, ok := err.(IndexErrors)
+	if !ok {
+		t.Fatal(err)
+	}
+	assert.Len(t, errs, 1)
+	assert.Equal(t, http.StatusNotFound, errs["missing"].(*Error).StatusCode)
+	assert.Len(t, resp, 1)
+	assert.NotNil(t, resp["a"])
+}
+
+func TestClientStats_WatchStats(t *testing.T) {
+	responses := []string{
+		`{"database_size":100,"indexes":{"movies":{"numberOfDocuments":1}}}`,
+		`{"database_size":100,"indexes":{"movies":{"numberOfDocuments":1}}}`,
+		`{"database_size":100,"indexes":{"movies":{"numberOfDocuments":1}}}`,
+		`{"database_size":200,"indexes":{"movies":{"numberOfDocuments":2}}}`,
+		`{"database_size":200,"indexes":{"movies":{"numberOfDocuments":2}}}`,
+	}
+	var mu sync.Mutex
+	polls := 0
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if polls >= len(responses) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(responses[polls]))
+		polls++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	statsChan, errChan := c.Stats().WatchStats(ctx, time.Millisecond)
+
+	var received []Stats
+	for len(received) < 2 {
+		select {
+		case stats := <-statsChan:
+			received = append(received, stats)
+		case err := <-errChan:
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, int64(100), received[0].DatabaseSize)
+	assert.Equal(t, int64(200), received[1].DatabaseSize)
+	assert.Equal(t, int64(2), received[1].Indexes["movies"].NumberOfDocuments)
+
+	// the last unchanged poll is not emitted, the next value is the error of the following poll
+	select {
+	case stats := <-statsChan:
+		t.Fatalf("unchanged stats should not be sent: %+v", stats)
+	case err := <-errChan:
+		assert.Equal(t, http.StatusInternalServerError, err.(*Error).StatusCode)
+	}
+	mu.Lock()
+	assert.Equal(t, len(responses), polls)
+	mu.Unlock()
+
+	cancel()
+	for range statsChan {
+	}
+	for range errChan {
+	}
+}
+
+func TestClientStats_IndexStatsManyWithContext(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"numberOfDocuments":1,"isIndexing":false}`))
+	})
+
+	// the first statsManyConcurrency indexes fit in the budget, the others do not
+	ctx, cancel := context.WithTimeout(context.Background(), 80*time.Millisecond)
+	defer cancel()
+	uids := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	resp, err := c.Stats().IndexStatsManyWithContext(ctx, uids)
+
+	errs, ok := err.(IndexErrors)
+	if !ok {
+		t.Fatalf("expected IndexErrors, got %v", err)
+	}
+	assert.Len(t, resp, statsManyConcurrency)
+	assert.Len(t, errs, len(uids)-statsManyConcurrency)
+	for uid, err := range errs {
+		if e, ok := err.(*Error); ok {
+			err = e.OriginError
+		}
+		assert.Equal(t, context.DeadlineExceeded, err, uid)
+	}
+}
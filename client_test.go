@@ -0,0 +1,1687 @@
+package meilisearch
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func TestClient_MaxResponseBodySize(t *testing.T) {
+	c := newMockClient(t, Config{MaxResponseBodySize: 64}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"commitSha":"` + strings.Repeat("a", 1024) + `"}`))
+	})
+
+	_, err := c.Version().Get()
+	if err == nil {
+		t.Fatal("an oversized body should be rejected")
+	}
+	if err.(*Error).ErrCode != ErrCodeResponseBodyTooLarge {
+		t.Fatal(err)
+	}
+
+	// A custom fasthttp.Client without limit is still guarded when reading the response.
+	custom := NewFastHTTPCustomClient(c.config, &fasthttp.Client{})
+	_, err = custom.Version().Get()
+	if err == nil {
+		t.Fatal("an oversized body should be rejected")
+	}
+	if err.(*Error).ErrCode != ErrCodeResponseBodyTooLarge {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_MaxResponseBodySize_ErrorBody(t *testing.T) {
+	c := newMockClient(t, Config{MaxResponseBodySize: 64}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"` + strings.Repeat("a", 1024) + `"}`))
+	})
+
+	custom := NewFastHTTPCustomClient(c.config, &fasthttp.Client{})
+	_, err := custom.Version().Get()
+	if err == nil {
+		t.Fatal("an oversized error body should be rejected")
+	}
+	e := err.(*Error)
+	assert.Equal(t, ErrCodeResponseBodyTooLarge, e.ErrCode)
+	assert.Equal(t, http.StatusInternalServerError, e.StatusCode)
+	assert.NotContains(t, e.ResponseToString, "aaaa", "the oversized body should not be kept")
+	assert.NotContains(t, e.MeilisearchMessage, "aaaa")
+}
+
+func TestClient_CanonicalJSON(t *testing.T) {
+	var bodies []string
+	c := newMockClient(t, Config{CanonicalJSON: true}, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	synonyms := map[string][]string{
+		"wolverine": {"xmen", "logan"},
+		"logan":     {"wolverine", "xmen"},
+		"xmen":      {"wolverine", "logan"},
+		"batman":    {"bruce wayne"},
+		"superman":  {"clark kent", "kal-el"},
+		"hulk":      {"bruce banner"},
+	}
+	for i := 0; i < 20; i++ {
+		if _, err := c.Settings("TestClient_CanonicalJSON").UpdateAll(Settings{Synonyms: synonyms}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := `{"synonyms":{"batman":["bruce wayne"],"hulk":["bruce banner"],"logan":["wolverine","xmen"],` +
+		`"superman":["clark kent","kal-el"],"wolverine":["xmen","logan"],"xmen":["wolverine","logan"]}}`
+	for _, body := range bodies {
+		if body != expected {
+			t.Fatalf("%s != %s", body, expected)
+		}
+	}
+}
+
+func Test_canonicalJSON(t *testing.T) {
+	got, err := canonicalJSON([]byte(`{ "b": 12345678901234567890, "a": {"d": "<em>", "c": [3.10, 1]} }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"a":{"c":[3.10,1],"d":"<em>"},"b":12345678901234567890}`
+	if string(got) != expected {
+		t.Fatalf("%s != %s", got, expected)
+	}
+}
+
+func TestClient_ForceReindex(t *testing.T) {
+	var calls []string
+	var settingsBody string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /indexes/movies/settings":
+			_, _ = w.Write([]byte(`{"rankingRules":["typo","words"],"distinctAttribute":null,"searchableAttributes":["title"],"displayedAttributes":["*"],"stopWords":[],"synonyms":{},"attributesForFaceting":[]}`))
+		case "POST /indexes/movies/settings":
+			body, _ := ioutil.ReadAll(r.Body)
+			settingsBody = string(body)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":7}`))
+		case "GET /indexes/movies/updates/7":
+			_, _ = w.Write([]byte(`{"status":"processed","updateId":7,"type":{"name":"Settings"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	update, err := c.ForceReindex(context.Background(), "movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"GET /indexes/movies/settings",
+		"POST /indexes/movies/settings",
+		"GET /indexes/movies/updates/7",
+		"GET /indexes/movies/updates/7",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("%v != %v", calls, expected)
+	}
+	assert.JSONEq(t, `{"rankingRules":["typo","words"],"searchableAttributes":["title"],"displayedAttributes":["*"]}`, settingsBody)
+	assert.Equal(t, UpdateStatusProcessed, update.Status)
+}
+
+func TestClient_WaitForPendingUpdate_Statuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		want     UpdateStatus
+	}{
+		{name: "processed", statuses: []string{"enqueued", "enqueued", "processed"}, want: UpdateStatusProcessed},
+		{name: "failed", statuses: []string{"enqueued", "failed"}, want: UpdateStatusFailed},
+		{name: "succeeded", statuses: []string{"enqueued", "processing", "processing", "succeeded"}, want: UpdateStatusSucceeded},
+		{name: "processing then failed", statuses: []string{"processing", "failed"}, want: UpdateStatusFailed},
+		{name: "canceled", statuses: []string{"enqueued", "canceled"}, want: UpdateStatusCanceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[calls]
+				calls++
+				_, _ = w.Write([]byte(`{"status":"` + status + `","updateId":1}`))
+			})
+
+			got, err := c.WaitForPendingUpdate(context.Background(), time.Millisecond, "movies", &AsyncUpdateID{UpdateID: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.statuses), calls)
+		})
+	}
+}
+
+func TestClient_WaitForPendingUpdate_Task(t *testing.T) {
+	var paths []string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path != "/tasks/7" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(paths) == 1 {
+			_, _ = w.Write([]byte(`{"uid":7,"indexUid":"movies","status":"enqueued","type":"settingsUpdate","error":null}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"uid":7,"indexUid":"movies","status":"failed","type":"settingsUpdate",` +
+			`"error":{"message":"Attribute rank is not sortable.","code":"invalid_settings_ranking_rules"},` +
+			`"enqueuedAt":"2023-02-08T10:00:00.000000Z","finishedAt":"2023-02-08T10:00:01.000000Z"}`))
+	})
+
+	var updateID AsyncUpdateID
+	if err := json.Unmarshal([]byte(`{"taskUid":7,"indexUid":"movies","status":"enqueued"}`), &updateID); err != nil {
+		t.Fatal(err)
+	}
+	status, err := c.WaitForPendingUpdate(context.Background(), time.Millisecond, "movies", &updateID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, UpdateStatusFailed, status)
+	assert.Equal(t, []string{"/tasks/7", "/tasks/7"}, paths)
+
+	update, err := c.getUpdate("movies", &updateID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(7), update.UpdateID)
+	assert.Equal(t, Unknown{"name": "settingsUpdate"}, update.Type)
+	assert.Equal(t, "Attribute rank is not sortable.", update.Error)
+	assert.Equal(t, time.Date(2023, 2, 8, 10, 0, 1, 0, time.UTC), update.ProcessedAt)
+}
+
+func TestClient_APIKeyHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		header string
+	}{
+		{name: "default", config: Config{APIKey: "masterKey"}, header: DefaultAPIKeyHeader},
+		{name: "custom", config: Config{APIKey: "masterKey", APIKeyHeader: "X-Proxy-Meili-Key"}, header: "X-Proxy-Meili-Key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var headers http.Header
+			c := newMockClient(t, tt.config, func(w http.ResponseWriter, r *http.Request) {
+				headers = r.Header
+				_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+			})
+
+			if _, err := c.Version().Get(); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "masterKey", headers.Get(tt.header))
+			if tt.header != DefaultAPIKeyHeader {
+				assert.Empty(t, headers.Get(DefaultAPIKeyHeader))
+			}
+		})
+	}
+}
+
+func TestClient_Headers(t *testing.T) {
+	var headers http.Header
+	c := newMockClient(t, Config{
+		APIKey: "masterKey",
+		Headers: map[string]string{
+			"X-Tenant-ID":       "tenant-42",
+			"Traceparent":       "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+			"Content-Type":      "text/plain",
+			DefaultAPIKeyHeader: "otherKey",
+		},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":1}`))
+	})
+
+	if _, err := c.Documents("movies").AddOrReplace([]map[string]interface{}{{"id": 1}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "tenant-42", headers.Get("X-Tenant-ID"))
+	assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", headers.Get("Traceparent"))
+	assert.Equal(t, "application/json", headers.Get("Content-Type"))
+	assert.Equal(t, "masterKey", headers.Get(DefaultAPIKeyHeader))
+}
+
+func TestClient_Diagnostics(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		c := newMockClient(t, Config{APIKey: "masterKey"}, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+			case "/version":
+				_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+			case "/indexes":
+				_, _ = w.Write([]byte(`[]`))
+			}
+		})
+
+		report, err := c.Diagnostics(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, report.OK)
+		assert.Equal(t, "0.17.0", report.Version)
+		assert.Len(t, report.Checks, 3)
+		for _, check := range report.Checks {
+			assert.True(t, check.OK, check.Name)
+			assert.Empty(t, check.Error)
+			assert.Greater(t, int64(check.Latency), int64(0))
+		}
+	})
+
+	t.Run("bad key", func(t *testing.T) {
+		c := newMockClient(t, Config{APIKey: "wrongKey"}, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/health":
+			case "/version":
+				_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+			default:
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message":"Invalid API key: wrongKey"}`))
+			}
+		})
+
+		report, err := c.Diagnostics(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, report.OK)
+		assert.Equal(t, []bool{true, true, false}, []bool{report.Checks[0].OK, report.Checks[1].OK, report.Checks[2].OK})
+		assert.Equal(t, DiagnosticCheckListIndexes, report.Checks[2].Name)
+		assert.Equal(t, http.StatusForbidden, report.Checks[2].StatusCode)
+		assert.Contains(t, report.Checks[2].Error, "Invalid API key")
+	})
+
+	t.Run("unreachable host", func(t *testing.T) {
+		c := NewClient(Config{Host: "http://127.0.0.1:1"}).(*Client)
+
+		report, err := c.Diagnostics(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, report.OK)
+		assert.Len(t, report.Checks, 3)
+		for _, check := range report.Checks {
+			assert.False(t, check.OK, check.Name)
+			assert.Equal(t, 0, check.StatusCode)
+			assert.NotEmpty(t, check.Error)
+		}
+	})
+
+	t.Run("ctx done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		report, err := NewClient(Config{Host: "http://127.0.0.1:1"}).Diagnostics(ctx)
+		assert.Equal(t, context.Canceled, err)
+		assert.Empty(t, report.Checks)
+	})
+
+	t.Run("ctx done during a check", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		report, err := c.Diagnostics(ctx)
+		assert.Less(t, int64(time.Since(start)), int64(time.Second), "the check should be aborted with ctx")
+		assert.Equal(t, context.DeadlineExceeded, err)
+		if assert.Len(t, report.Checks, 1) {
+			assert.Equal(t, DiagnosticCheckHealth, report.Checks[0].Name)
+			assert.False(t, report.Checks[0].OK)
+		}
+	})
+}
+
+func TestClient_RawHTTPClient(t *testing.T) {
+	httpClient := &fasthttp.Client{Name: "custom"}
+	c := NewFastHTTPCustomClient(Config{}, httpClient)
+	assert.Same(t, httpClient, c.RawHTTPClient())
+
+	var userAgent string
+	mock := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	})
+	mock.RawHTTPClient().Name = "tweaked"
+	if _, err := mock.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "tweaked", userAgent)
+}
+
+func TestClient_TLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	tlsConfig := &tls.Config{RootCAs: pool}
+
+	c := NewClient(Config{Host: server.URL, TLSConfig: tlsConfig})
+	assert.Same(t, tlsConfig, c.RawHTTPClient().TLSConfig)
+	if _, err := c.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewClient(Config{Host: server.URL}).Version().Get(); err == nil {
+		t.Fatal("the self-signed certificate should be rejected")
+	}
+
+	insecure := NewClient(Config{Host: server.URL, TLSConfig: &tls.Config{ServerName: "meili"}, InsecureSkipVerify: true})
+	assert.True(t, insecure.RawHTTPClient().TLSConfig.InsecureSkipVerify)
+	assert.Equal(t, "meili", insecure.RawHTTPClient().TLSConfig.ServerName)
+	if _, err := insecure.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_cachedSettings_TTL(t *testing.T) {
+	settingsCalls := 0
+	c := newMockClient(t, Config{SettingsCacheTTL: 10 * time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+		settingsCalls++
+		_, _ = w.Write([]byte(`{"displayedAttributes":["*"]}`))
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.cachedSettings("movies"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assert.Equal(t, 1, settingsCalls)
+
+	time.Sleep(20 * time.Millisecond)
+	if _, err := c.cachedSettings("movies"); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, settingsCalls)
+}
+
+func TestClient_cachedSettings_PendingUpdate(t *testing.T) {
+	var mu sync.Mutex
+	displayed, status := `["*"]`, ""
+	var settingsCalls, statusCalls int
+	c := newMockClient(t, Config{SettingsCacheTTL: time.Hour}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost:
+			status = "enqueued"
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+		case r.URL.Path == "/indexes/movies/updates/1":
+			statusCalls++
+			_, _ = w.Write([]byte(`{"status":"` + status + `","updateId":1}`))
+		default:
+			settingsCalls++
+			_, _ = w.Write([]byte(`{"displayedAttributes":` + displayed + `}`))
+		}
+	})
+	get := func() []string {
+		settings, err := c.cachedSettings("movies")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return settings.DisplayedAttributes
+	}
+
+	assert.Equal(t, []string{"*"}, get())
+	if _, err := c.Settings("movies").UpdateDisplayedAttributes([]string{"title"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"*"}, get())
+	assert.Equal(t, []string{"*"}, get())
+	assert.Equal(t, 3, settingsCalls, "the settings should not be cached while the update is pending")
+
+	mu.Lock()
+	displayed, status = `["title"]`, "processed"
+	mu.Unlock()
+	assert.Equal(t, []string{"title"}, get())
+	assert.Equal(t, []string{"title"}, get())
+	assert.Equal(t, 4, settingsCalls, "the settings should be cached again once the update is processed")
+	assert.Equal(t, 3, statusCalls)
+
+	get()[0] = "changed"
+	assert.Equal(t, []string{"title"}, get(), "the cache should not be changed through the returned settings")
+}
+
+func TestClient_PendingTaskCount(t *testing.T) {
+	t.Run("tasks endpoint", func(t *testing.T) {
+		var queries []url.Values
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/tasks" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			queries = append(queries, r.URL.Query())
+			_, _ = w.Write([]byte(`{"results":[],"total":7,"limit":0,"from":null,"next":null}`))
+		})
+
+		count, err := c.PendingTaskCount("movies")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 7, count)
+
+		count, err = c.TotalPendingTaskCount()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 7, count)
+
+		assert.Equal(t, url.Values{"statuses": {"enqueued,processing"}, "limit": {"0"}, "indexUids": {"movies"}}, queries[0])
+		assert.Equal(t, url.Values{"statuses": {"enqueued,processing"}, "limit": {"0"}}, queries[1])
+	})
+
+	t.Run("updates endpoint", func(t *testing.T) {
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/indexes":
+				_, _ = w.Write([]byte(`[{"uid":"movies"},{"uid":"books"}]`))
+			case "/indexes/movies/updates":
+				_, _ = w.Write([]byte(`[{"status":"processed","updateId":0},{"status":"enqueued","updateId":1},{"status":"enqueued","updateId":2}]`))
+			case "/indexes/books/updates":
+				_, _ = w.Write([]byte(`[{"status":"failed","updateId":0},{"status":"processing","updateId":1}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		count, err := c.PendingTaskCount("movies")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 2, count)
+
+		count, err = c.TotalPendingTaskCount()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 3, count)
+	})
+}
+
+func TestClient_WaitForSearchable(t *testing.T) {
+	calls := 0
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/indexes/movies/documents/123" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if calls < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Document 123 not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"123"}`))
+	})
+
+	if err := c.WaitForSearchable(context.Background(), "movies", "123", time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	c = newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	assert.Equal(t, context.DeadlineExceeded, c.WaitForSearchable(ctx, "movies", "123", 5*time.Millisecond))
+	assert.Greater(t, calls, 1)
+
+	c = newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	err := c.WaitForSearchable(context.Background(), "movies", "123", time.Millisecond)
+	assert.Equal(t, http.StatusForbidden, err.(*Error).StatusCode)
+}
+
+func TestClient_WaitForPendingUpdate_IndexDeleted(t *testing.T) {
+	for _, body := range []string{
+		`{"message":"Index movies not found","errorCode":"index_not_found","errorType":"invalid_request_error"}`,
+		`{"message":"Index movies not found","code":"index_not_found","type":"invalid_request"}`,
+	} {
+		calls := 0
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				_, _ = w.Write([]byte(`{"status":"enqueued","updateId":1}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(body))
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		status, err := c.WaitForPendingUpdate(ctx, time.Millisecond, "movies", &AsyncUpdateID{UpdateID: 1})
+		cancel()
+		assert.Equal(t, UpdateStatusUnknown, status)
+		assert.True(t, errors.Is(err, ErrIndexUnavailable), "%v should be ErrIndexUnavailable", err)
+		assert.Equal(t, "index_not_found", err.(*Error).MeilisearchCode)
+		assert.Equal(t, 3, calls)
+
+		err = c.WaitForSearchable(context.Background(), "movies", "1", time.Millisecond)
+		assert.True(t, errors.Is(err, ErrIndexUnavailable), "%v should be ErrIndexUnavailable", err)
+	}
+
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Document 1 not found","errorCode":"document_not_found"}`))
+	})
+	err := c.Documents("movies").GetInto("1", &map[string]interface{}{})
+	assert.False(t, errors.Is(err, ErrIndexUnavailable))
+}
+
+func TestClient_WaitForAll(t *testing.T) {
+	var mu sync.Mutex
+	polls := map[string]int{}
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/indexes/movies/updates/")
+		mu.Lock()
+		polls[id]++
+		first := polls[id] == 1
+		mu.Unlock()
+
+		switch {
+		case first:
+			_, _ = w.Write([]byte(`{"status":"enqueued","updateId":` + id + `}`))
+		case id == "2":
+			_, _ = w.Write([]byte(`{"status":"failed","updateId":2,"error":"document id is missing"}`))
+		case id == "4":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Update 4 not found"}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"processed","updateId":` + id + `}`))
+		}
+	})
+
+	result, err := c.WaitForAll(context.Background(), "movies",
+		[]*AsyncUpdateID{{UpdateID: 1}, {UpdateID: 2}, {UpdateID: 3}, {UpdateID: 4}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 2, result.Succeeded)
+	assert.Equal(t, 2, result.Failed)
+	assert.Equal(t, BatchOutcome{UpdateID: 1, Status: UpdateStatusProcessed}, result.Outcomes[0])
+	assert.Equal(t, BatchOutcome{UpdateID: 2, Status: UpdateStatusFailed, Error: "document id is missing"}, result.Outcomes[1])
+	assert.Equal(t, BatchOutcome{UpdateID: 3, Status: UpdateStatusProcessed}, result.Outcomes[2])
+	assert.Equal(t, UpdateStatusEnqueued, result.Outcomes[3].Status)
+	assert.Contains(t, result.Outcomes[3].Error, "Update 4 not found")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	result, err = c.WaitForAll(ctx, "movies", []*AsyncUpdateID{{UpdateID: 5}}, 0)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, BatchOutcome{UpdateID: 5, Status: UpdateStatusEnqueued, Error: context.DeadlineExceeded.Error()}, result.Outcomes[0])
+	assert.Equal(t, 1, result.Failed)
+}
+
+func TestClient_WaitForAll_Concurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	polls := map[string]int{}
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/indexes/movies/updates/")
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		polls[id]++
+		status := "enqueued"
+		if polls[id] > 2 {
+			status = "processed"
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"status":"` + status + `","updateId":` + id + `}`))
+	})
+
+	updateIDs := make([]*AsyncUpdateID, 10)
+	for i := range updateIDs {
+		updateIDs[i] = &AsyncUpdateID{UpdateID: int64(i)}
+	}
+	result, err := c.WaitForAll(context.Background(), "movies", updateIDs, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 10, result.Succeeded)
+	assert.Equal(t, 3, maxInFlight)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	updateIDs = append(updateIDs, &AsyncUpdateID{UpdateID: 10})
+	mu.Lock()
+	for i := range updateIDs {
+		// keeps the updates enqueued until ctx is done
+		polls[strconv.Itoa(i)] = -1000
+	}
+	mu.Unlock()
+	started := time.Now()
+	result, err = c.WaitForAll(ctx, "movies", updateIDs, 2)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Less(t, int64(time.Since(started)), int64(time.Second), "the workers should stop with ctx")
+	assert.Equal(t, 11, result.Failed)
+	assert.Equal(t, BatchOutcome{UpdateID: 10, Status: UpdateStatusUnknown, Error: context.DeadlineExceeded.Error()},
+		result.Outcomes[10])
+}
+
+func TestClient_CopySettings(t *testing.T) {
+	var calls []string
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		calls = append(calls, call)
+		switch call {
+		case "GET /indexes/movies", "GET /indexes/staging":
+			_, _ = w.Write([]byte(`{"uid":"` + strings.TrimPrefix(r.URL.Path, "/indexes/") + `"}`))
+		case "GET /indexes/movies/settings":
+			_, _ = w.Write([]byte(`{"rankingRules":["typo","words"],"distinctAttribute":"movie_id","searchableAttributes":["title"],"displayedAttributes":["*"],"stopWords":[],"synonyms":{"logan":["wolverine"]},"attributesForFaceting":["genre"]}`))
+		case "POST /indexes/staging/settings":
+			raw, _ := ioutil.ReadAll(r.Body)
+			body = string(raw)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":2}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index not found","errorCode":"index_not_found"}`))
+		}
+	})
+
+	updateID, err := c.CopySettings("movies", "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(2), updateID.UpdateID)
+	assert.Equal(t, []string{
+		"GET /indexes/staging",
+		"GET /indexes/movies/settings",
+		"POST /indexes/staging/settings",
+	}, calls, "the settings should be copied in a single update")
+	assert.JSONEq(t, `{"rankingRules":["typo","words"],"distinctAttribute":"movie_id","searchableAttributes":["title"],"displayedAttributes":["*"],"stopWords":[],"synonyms":{"logan":["wolverine"]},"attributesForFaceting":["genre"]}`, body,
+		"every setting should be sent, the empty ones included")
+
+	calls = nil
+	_, err = c.CopySettings("unknown", "staging")
+	assert.True(t, errors.Is(err, ErrIndexUnavailable), "%v should be ErrIndexUnavailable", err)
+	_, err = c.CopySettings("movies", "unknown")
+	assert.True(t, errors.Is(err, ErrIndexUnavailable), "%v should be ErrIndexUnavailable", err)
+	for _, call := range calls {
+		assert.NotEqual(t, http.MethodPost, strings.Split(call, " ")[0], "nothing should be changed")
+	}
+}
+
+func TestClient_ListModifiedSince(t *testing.T) {
+	var bodies []map[string]interface{}
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes/movies/settings" {
+			_, _ = w.Write([]byte(`{"displayedAttributes":["*"]}`))
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		bodies = append(bodies, body)
+
+		hits := []map[string]interface{}{}
+		offset, _ := body["offset"].(float64)
+		for i := int(offset); i < 1500 && len(hits) < int(body["limit"].(float64)); i++ {
+			hits = append(hits, map[string]interface{}{"id": i, "updated_at": 1600000000 + i})
+		}
+		data, _ := json.Marshal(map[string]interface{}{"hits": hits})
+		_, _ = w.Write(data)
+	})
+
+	var documents []struct {
+		ID        int   `json:"id"`
+		UpdatedAt int64 `json:"updated_at"`
+	}
+	since := time.Unix(1599999999, 0)
+	if err := c.ListModifiedSince(context.Background(), "movies", "updated_at", since, &documents); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, documents, 1500)
+	assert.Equal(t, 1499, documents[1499].ID)
+	assert.Len(t, bodies, 2)
+	for i, body := range bodies {
+		assert.Equal(t, "updated_at > 1599999999", body["filter"])
+		assert.Nil(t, body["filters"])
+		assert.Equal(t, []interface{}{"updated_at:asc"}, body["sort"])
+		assert.Equal(t, float64(1000), body["limit"])
+		if i == 1 {
+			assert.Equal(t, float64(1000), body["offset"])
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, c.ListModifiedSince(ctx, "movies", "updated_at", since, &documents))
+}
+
+func TestClient_ListModifiedSince_Raw(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/settings", "/indexes/slow/settings":
+			_, _ = w.Write([]byte(`{"displayedAttributes":["*"]}`))
+		case "/indexes/slow/search":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["offset"] != nil {
+				<-release
+				return
+			}
+			hits := []map[string]interface{}{}
+			for i := 0; i < listModifiedSincePageSize; i++ {
+				hits = append(hits, map[string]interface{}{"id": i})
+			}
+			data, _ := json.Marshal(map[string]interface{}{"hits": hits})
+			_, _ = w.Write(data)
+		default:
+			_, _ = w.Write([]byte(`{"hits":[{"id":9007199254740993,"updated_at":1600000000}]}`))
+		}
+	})
+
+	var documents []struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.ListModifiedSince(context.Background(), "movies", "updated_at", time.Unix(0, 0), &documents); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(9007199254740993), documents[0].ID, "the hits should be decoded as received")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := c.ListModifiedSince(ctx, "slow", "updated_at", time.Unix(0, 0), &documents)
+	assert.Equal(t, context.DeadlineExceeded, err, "the page request should be aborted with ctx")
+	assert.Len(t, documents, listModifiedSincePageSize, "the documents already fetched should be decoded")
+}
+
+func TestClient_ListModifiedSince_MaxTotalHits(t *testing.T) {
+	var limits []float64
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes/movies/settings" {
+			_, _ = w.Write([]byte(`{"displayedAttributes":["*"],"pagination":{"maxTotalHits":1200}}`))
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		limits = append(limits, body["limit"].(float64))
+
+		// hits beyond maxTotalHits are not returned
+		hits := []map[string]interface{}{}
+		offset, _ := body["offset"].(float64)
+		for i := int(offset); i < 1200 && len(hits) < int(body["limit"].(float64)); i++ {
+			hits = append(hits, map[string]interface{}{"id": i})
+		}
+		data, _ := json.Marshal(map[string]interface{}{"hits": hits})
+		_, _ = w.Write(data)
+	})
+
+	var documents []map[string]interface{}
+	err := c.ListModifiedSince(context.Background(), "movies", "updated_at", time.Unix(0, 0), &documents)
+	assert.Equal(t, ErrPaginationLimitReached, err)
+	assert.Len(t, documents, 1200)
+	assert.Equal(t, []float64{1000, 200}, limits)
+}
+
+func TestClient_WaitForPendingUpdateAsync(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	processed := false
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		polls++
+		if processed {
+			_, _ = w.Write([]byte(`{"status":"processed","updateId":1}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"enqueued","updateId":1}`))
+	})
+
+	results, cancel := c.WaitForPendingUpdateAsync(time.Millisecond, "movies", &AsyncUpdateID{UpdateID: 1})
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	processed = true
+	mu.Unlock()
+
+	result, ok := <-results
+	assert.True(t, ok)
+	assert.Equal(t, WaitResult{Status: UpdateStatusProcessed}, result)
+	_, ok = <-results
+	assert.False(t, ok, "the channel should be closed after the result")
+	cancel()
+
+	mu.Lock()
+	processed = false
+	mu.Unlock()
+	results, cancel = c.WaitForPendingUpdateAsync(time.Millisecond, "movies", &AsyncUpdateID{UpdateID: 1})
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case _, ok := <-results:
+		assert.False(t, ok, "no result should be sent once cancelled")
+	case <-time.After(time.Second):
+		t.Fatal("the channel should be closed once cancelled")
+	}
+	mu.Lock()
+	pollsAfterCancel := polls
+	mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, pollsAfterCancel, polls, "the polling should be stopped")
+	mu.Unlock()
+}
+
+func TestClient_Dial(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var dialed []string
+	c := NewClient(Config{
+		Host: "http://meilisearch.internal:7700",
+		Dial: func(addr string) (net.Conn, error) {
+			dialed = append(dialed, addr)
+			return net.Dial("tcp", server.Listener.Addr().String())
+		},
+	})
+
+	version, err := c.Version().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0.17.0", version.PkgVersion)
+	assert.Equal(t, []string{"meilisearch.internal:7700"}, dialed)
+}
+
+func TestError_Unwrap(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"numberOfDocuments":1}`))
+	})
+	stats := newClientStats(c)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := stats.get(ctx, "movies")
+	assert.True(t, errors.Is(err, context.Canceled), "%v should be context.Canceled", err)
+	var meiliError *Error
+	if assert.True(t, errors.As(err, &meiliError)) {
+		assert.Equal(t, ErrCodeRequestExecution, meiliError.ErrCode)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+	_, err = stats.get(ctx, "movies")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v should be context.DeadlineExceeded", err)
+	assert.False(t, errors.Is(err, context.Canceled))
+
+	_, err = NewClient(Config{Host: "http://local host:7700"}).Version().Get()
+	if assert.True(t, errors.As(err, &meiliError), "%v should be an *Error", err) {
+		assert.Equal(t, ErrCodeURLParsing, meiliError.ErrCode)
+	}
+	var urlError *url.Error
+	assert.True(t, errors.As(err, &urlError), "%v should wrap an *url.Error", err)
+}
+
+func TestClient_pollInterval(t *testing.T) {
+	c := Client{}
+	assert.Equal(t, 50*time.Millisecond, c.pollInterval(50*time.Millisecond))
+
+	c.config.PollJitter = 0.2
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 100; i++ {
+		interval := c.pollInterval(50 * time.Millisecond)
+		assert.GreaterOrEqual(t, int64(interval), int64(40*time.Millisecond))
+		assert.LessOrEqual(t, int64(interval), int64(60*time.Millisecond))
+		seen[interval] = true
+	}
+	assert.Greater(t, len(seen), 1, "the intervals should vary")
+
+	c.config.PollJitter = 3
+	for i := 0; i < 100; i++ {
+		assert.GreaterOrEqual(t, int64(c.pollInterval(50*time.Millisecond)), int64(0))
+	}
+}
+
+func TestClient_CreateIndexWithUpdate(t *testing.T) {
+	t.Run("task", func(t *testing.T) {
+		var calls []string
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"taskUid":12,"indexUid":"movies","status":"enqueued","type":"indexCreation"}`))
+				return
+			}
+			if r.URL.Path != "/tasks/12" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"uid":12,"indexUid":"movies","status":"processing","type":"indexCreation",` +
+				`"error":null,"enqueuedAt":"2023-02-08T10:00:00.000000Z","finishedAt":null}`))
+		})
+
+		resp, update, err := c.CreateIndexWithUpdate(CreateIndexRequest{UID: "movies"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []string{"POST /indexes", "GET /tasks/12"}, calls)
+		assert.Equal(t, "movies", resp.UID)
+		assert.Equal(t, UpdateStatusProcessing, update.Status)
+		assert.Equal(t, int64(12), update.UpdateID)
+		assert.Equal(t, Unknown{"name": "indexCreation"}, update.Type)
+	})
+
+	t.Run("synchronous", func(t *testing.T) {
+		var calls []string
+		c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"uid":"movies","name":"movies","primaryKey":"movie_id"}`))
+		})
+
+		resp, update, err := c.CreateIndexWithUpdate(CreateIndexRequest{UID: "movies"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []string{"POST /indexes"}, calls)
+		assert.Equal(t, "movie_id", resp.PrimaryKey)
+		assert.Nil(t, update)
+	})
+}
+
+func TestClient_RelevanceConfig(t *testing.T) {
+	source := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"rankingRules":["typo","words"],"distinctAttribute":"movie_id","searchableAttributes":["title"],"displayedAttributes":["*"],"stopWords":[],"synonyms":{"logan":["wolverine"]},"attributesForFaceting":["genre"]}`))
+	})
+
+	data, err := source.ExportRelevanceConfig("movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{"version":1,"rankingRules":["typo","words"],"synonyms":{"logan":["wolverine"]},"stopWords":[],"searchableAttributes":["title"]}`, string(data))
+
+	bodies := map[string]string{}
+	updateID := int64(0)
+	target := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		bodies[r.Method+" "+r.URL.Path] = string(raw)
+		updateID++
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":` + strconv.FormatInt(updateID, 10) + `}`))
+	})
+
+	updateIDs, err := target.ImportRelevanceConfig("production", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []*AsyncUpdateID{{UpdateID: 1}, {UpdateID: 2}, {UpdateID: 3}, {UpdateID: 4}}, updateIDs)
+	assert.Len(t, bodies, 4)
+	assert.JSONEq(t, `["typo","words"]`, bodies["POST /indexes/production/settings/ranking-rules"])
+	assert.JSONEq(t, `{"logan":["wolverine"]}`, bodies["POST /indexes/production/settings/synonyms"])
+	assert.JSONEq(t, `[]`, bodies["POST /indexes/production/settings/stop-words"])
+	assert.JSONEq(t, `["title"]`, bodies["POST /indexes/production/settings/searchable-attributes"])
+
+	_, err = target.ImportRelevanceConfig("production", []byte(`{"version":2,"rankingRules":["typo"]}`))
+	assert.Error(t, err)
+	_, err = target.ImportRelevanceConfig("production", []byte(`not json`))
+	assert.Error(t, err)
+	assert.Len(t, bodies, 4, "an invalid config should not be applied")
+}
+
+func TestClient_DetectNewFields(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/stats":
+			_, _ = w.Write([]byte(`{"numberOfDocuments":3,"isIndexing":false,"fieldDistribution":{"movie_id":3,"title":3,"overview":2,"poster":1,"release.year":1}}`))
+		case "/indexes/movies/settings":
+			_, _ = w.Write([]byte(`{"displayedAttributes":["movie_id","title","overview","release"],"searchableAttributes":["title","overview","movie_id","release"]}`))
+		case "/indexes/books/stats":
+			_, _ = w.Write([]byte(`{"numberOfDocuments":1,"isIndexing":false,"fieldsFrequency":{"book_id":1,"title":1}}`))
+		case "/indexes/books/settings":
+			_, _ = w.Write([]byte(`{"displayedAttributes":["*"],"searchableAttributes":["title"]}`))
+		}
+	})
+
+	fields, err := c.DetectNewFields("movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"poster"}, fields)
+
+	fields, err = c.DetectNewFields("books")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"book_id"}, fields)
+}
+
+func TestClient_WithResponseHeaders(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-"+r.URL.Query().Get("id"))
+		w.Header().Set("Server-Timing", "search;dur=3")
+		if r.URL.Path == "/indexes/unknown" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index unknown not found","errorCode":"index_not_found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	})
+
+	var headers map[string]string
+	withHeaders := c.WithResponseHeaders(&headers)
+	if _, err := withHeaders.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "req-", headers["X-Request-Id"])
+	assert.Equal(t, "search;dur=3", headers["Server-Timing"])
+
+	headers = nil
+	_, err := withHeaders.Indexes().Get("unknown")
+	assert.Error(t, err)
+	assert.Equal(t, "search;dur=3", headers["Server-Timing"], "the headers of a failed request should be captured")
+
+	headers = nil
+	if _, err := c.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, headers, "the original client should not capture the headers")
+}
+
+func TestClient_WithContext(t *testing.T) {
+	release := make(chan struct{})
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes" {
+			select {
+			case <-release:
+			case <-time.After(time.Second):
+			}
+		}
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	})
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	started := time.Now()
+	_, err := c.WithContext(ctx).Indexes().List()
+	assert.True(t, errors.Is(err, context.Canceled), "%v should be context.Canceled", err)
+	assert.Less(t, int64(time.Since(started)), int64(500*time.Millisecond), "the request should be aborted")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.WithContext(ctx).Indexes().List()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v should be context.DeadlineExceeded", err)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	version, err := c.WithContext(ctx).Version().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0.17.0", version.PkgVersion)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	c := newMockClient(t, Config{Timeout: 20 * time.Millisecond}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/indexes" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	})
+
+	started := time.Now()
+	_, err := c.Version().Get()
+	assert.True(t, errors.Is(err, fasthttp.ErrTimeout), "%v should be a timeout", err)
+	assert.Less(t, int64(time.Since(started)), int64(150*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	_, err = c.WithContext(ctx).Version().Get()
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "the sooner ctx deadline should apply, got %v", err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	_, err = c.WithContext(ctx).Version().Get()
+	assert.True(t, errors.Is(err, fasthttp.ErrTimeout), "the sooner timeout should apply, got %v", err)
+
+	if _, err := c.Indexes().List(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		call := calls[r.Method+" "+r.URL.Path]
+		mu.Unlock()
+
+		if call <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message":"restarting"}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	retry := RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	c := NewClient(Config{Host: server.URL, Retry: retry})
+
+	version, err := c.Version().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0.17.0", version.PkgVersion)
+	assert.Equal(t, 3, calls["GET /version"])
+
+	_, err = c.Documents("movies").AddOrReplace([]map[string]interface{}{{"id": 1}})
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusServiceUnavailable, err.(*Error).StatusCode)
+	}
+	assert.Equal(t, 1, calls["POST /indexes/movies/documents"], "a POST should not be retried by default")
+
+	retry.RetryPOST = true
+	c = NewClient(Config{Host: server.URL, Retry: retry})
+	if _, err := c.Documents("books").AddOrReplace([]map[string]interface{}{{"id": 1}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, calls["POST /indexes/books/documents"])
+
+	dials := 0
+	c = NewClient(Config{Host: server.URL, Retry: retry, Dial: func(addr string) (net.Conn, error) {
+		dials++
+		if dials <= 2 {
+			return nil, syscall.ECONNREFUSED
+		}
+		return net.Dial("tcp", addr)
+	}})
+	if _, err := c.Indexes().Delete("movies"); err == nil {
+		t.Fatal("the third attempt should get the first 503")
+	}
+	assert.Equal(t, 3, dials)
+	assert.Equal(t, 1, calls["DELETE /indexes/movies"])
+
+	c = NewClient(Config{Host: server.URL, Retry: RetryConfig{MaxAttempts: 5, BaseDelay: time.Millisecond}})
+	if _, err := c.Indexes().Get("unknown"); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, calls["GET /indexes/unknown"])
+}
+
+func TestClient_RetryMaxElapsedTime(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"message":"restarting"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(Config{Host: server.URL, Retry: RetryConfig{
+		MaxAttempts:    100,
+		BaseDelay:      10 * time.Millisecond,
+		MaxDelay:       10 * time.Millisecond,
+		MaxElapsedTime: 100 * time.Millisecond,
+	}})
+
+	started := time.Now()
+	_, err := c.Indexes().Delete("movies")
+	elapsed := time.Since(started)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusServiceUnavailable, err.(*Error).StatusCode, "the last error should be returned")
+	}
+	assert.Less(t, int64(elapsed), int64(200*time.Millisecond))
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&calls), int32(2))
+	assert.LessOrEqual(t, atomic.LoadInt32(&calls), int32(4), "the retries should stop once the budget is spent")
+}
+
+func TestRetryConfig_delay(t *testing.T) {
+	retry := RetryConfig{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	assert.Equal(t, 10*time.Millisecond, retry.delay(1))
+	assert.Equal(t, 20*time.Millisecond, retry.delay(2))
+	assert.Equal(t, 40*time.Millisecond, retry.delay(3))
+	assert.Equal(t, 50*time.Millisecond, retry.delay(4))
+	assert.Equal(t, 50*time.Millisecond, retry.delay(40))
+	assert.Equal(t, DefaultRetryBaseDelay, RetryConfig{}.delay(1))
+
+	retry.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := retry.delay(2)
+		assert.GreaterOrEqual(t, int64(delay), int64(10*time.Millisecond))
+		assert.LessOrEqual(t, int64(delay), int64(30*time.Millisecond))
+	}
+}
+
+func TestClient_RequestObserver(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var events []RequestEvent
+	c := NewClient(Config{
+		Host:            server.URL,
+		Retry:           RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+		RequestObserver: func(event RequestEvent) { events = append(events, event) },
+	})
+	if _, err := c.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, 1, events[0].Attempt)
+		assert.False(t, events[0].Final)
+		assert.Equal(t, http.StatusBadGateway, events[0].StatusCode)
+		assert.Error(t, events[0].Err)
+
+		assert.Equal(t, 2, events[1].Attempt)
+		assert.True(t, events[1].Final)
+		assert.Equal(t, http.StatusOK, events[1].StatusCode)
+		assert.NoError(t, events[1].Err)
+		assert.Equal(t, http.MethodGet, events[1].Method)
+		assert.Equal(t, "/version", events[1].Endpoint)
+		assert.Equal(t, "Version.Get", events[1].Function)
+	}
+}
+
+func TestClient_ReadWriteHosts(t *testing.T) {
+	newServer := func(calls *[]string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/search"):
+				_, _ = w.Write([]byte(`{"hits":[],"nbHits":0}`))
+			case r.Method == http.MethodGet:
+				_, _ = w.Write([]byte(`{"pkgVersion":"0.17.0"}`))
+			default:
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"updateId":1}`))
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	var hostCalls, readCalls, writeCalls []string
+	host, read, write := newServer(&hostCalls), newServer(&readCalls), newServer(&writeCalls)
+
+	c := NewClient(Config{Host: host.URL, ReadHost: read.URL, WriteHost: write.URL})
+	if _, err := c.Search("movies").Search(SearchRequest{Query: "prince"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Version().Get(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Documents("movies").AddOrReplace([]map[string]interface{}{{"id": 1}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"POST /indexes/movies/search", "GET /version"}, readCalls)
+	assert.Equal(t, []string{"POST /indexes/movies/documents"}, writeCalls)
+	assert.Empty(t, hostCalls)
+
+	readCalls, writeCalls = nil, nil
+	c = NewClient(Config{Host: host.URL, ReadHost: read.URL})
+	if _, err := c.Search("movies").Search(SearchRequest{Query: "prince"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Documents("movies").AddOrReplace([]map[string]interface{}{{"id": 1}}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"POST /indexes/movies/search"}, readCalls)
+	assert.Equal(t, []string{"POST /indexes/movies/documents"}, hostCalls, "the writes should fall back to Host")
+}
+
+func TestClient_ReadWriteHosts_WriteStatus(t *testing.T) {
+	var readCalls, writeCalls []string
+	read := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		readCalls = append(readCalls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"not found on the replica"}`))
+	}))
+	t.Cleanup(read.Close)
+	write := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeCalls = append(writeCalls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.URL.Path == "/indexes/movies/updates/1":
+			_, _ = w.Write([]byte(`{"status":"processed","updateId":1}`))
+		case r.URL.Path == "/tasks/2":
+			_, _ = w.Write([]byte(`{"uid":2,"status":"succeeded","type":"settingsUpdate","error":null}`))
+		case r.URL.Path == "/tasks":
+			_, _ = w.Write([]byte(`{"results":[],"total":0}`))
+		case r.URL.Path == "/batches/3":
+			_, _ = w.Write([]byte(`{"uid":3}`))
+		case r.URL.Path == "/dumps/20210812-100000000/status":
+			_, _ = w.Write([]byte(`{"uid":"20210812-100000000","status":"done"}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"rankingRules":["words"]}`))
+		default:
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+		}
+	}))
+	t.Cleanup(write.Close)
+
+	c := NewClient(Config{Host: write.URL, ReadHost: read.URL, WriteHost: write.URL})
+	ctx := context.Background()
+	status, err := c.WaitForPendingUpdate(ctx, time.Millisecond, "movies", &AsyncUpdateID{UpdateID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, UpdateStatusProcessed, status)
+	result, err := c.WaitForAll(ctx, "movies", []*AsyncUpdateID{{UpdateID: 1}, {UpdateID: 2, IsTask: true}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, result.Succeeded)
+	if _, err := c.TotalPendingTaskCount(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Batches().Get(3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.WaitForDump(ctx, time.Millisecond, "20210812-100000000"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ForceReindex(ctx, "movies"); err != nil {
+		t.Fatal(err)
+	}
+	err = c.Settings("movies").UpdateWithRollback(func(settings APISettings) error {
+		_, err := settings.UpdateRankingRules([]string{"typo"})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, readCalls, "the status of the writes and the snapshots should be read from the write host")
+	assert.Contains(t, writeCalls, "GET /indexes/movies/settings")
+
+	if _, err := c.Version().Get(); err == nil {
+		t.Fatal("the other reads should still be sent to the read host")
+	}
+	assert.Equal(t, []string{"GET /version"}, readCalls)
+}
+
+func TestClient_WaitForDump(t *testing.T) {
+	polls := 0
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "in_progress"
+		if polls == 3 && r.URL.Path == "/dumps/dump/status" {
+			status = "done"
+		}
+		_, _ = w.Write([]byte(`{"uid":"dump","status":"` + status + `"}`))
+	})
+
+	status, err := c.WaitForDump(context.Background(), time.Millisecond, "dump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DumpStatusDone, status)
+	assert.Equal(t, 3, polls)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitForDump(ctx, time.Millisecond, "stuck")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v should be context.DeadlineExceeded", err)
+}
+
+func TestClient_IncompleteResponse(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/indexes/malformed/search" {
+			_, _ = w.Write([]byte(`{"hits":[{"id":1},`))
+			return
+		}
+		if r.Method == http.MethodDelete && calls > 1 {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"updateId":1}`))
+			return
+		}
+
+		// the connection is closed after a part of the body
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_, _ = buf.WriteString("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\nContent-Length: 100\r\n\r\n" +
+			`{"hits":[{"id":1},`)
+		_ = buf.Flush()
+		_ = conn.Close()
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(Config{Host: server.URL})
+	_, err := c.Search("movies").Search(SearchRequest{Query: "prince"})
+	assert.True(t, errors.Is(err, ErrIncompleteResponse), "%v should be ErrIncompleteResponse", err)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, ErrCodeResponseReadBody, err.(*Error).ErrCode)
+	}
+
+	_, err = c.Search("malformed").Search(SearchRequest{Query: "prince"})
+	assert.False(t, errors.Is(err, ErrIncompleteResponse), "%v should be a malformed response", err)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, ErrCodeResponseUnmarshalBody, err.(*Error).ErrCode)
+	}
+
+	calls = 0
+	c = NewClient(Config{Host: server.URL, Retry: RetryConfig{MaxAttempts: 2, BaseDelay: time.Millisecond}})
+	updateID, err := c.Documents("movies").Delete("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1), updateID.UpdateID)
+	assert.Equal(t, 2, calls, "an incomplete response should be retried")
+}
+
+func TestClient_DumpDocumentsToFile(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if r.URL.Path == "/indexes/cancelled/documents" && offset > 0 {
+			cancel()
+		}
+		var documents []string
+		for i := offset; i < offset+limit && i < 2500; i++ {
+			documents = append(documents, "{\n  \"id\": "+strconv.Itoa(i)+"\n}")
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(documents, ",") + "]"))
+	})
+
+	path := filepath.Join(t.TempDir(), "movies.ndjson")
+	written, err := c.DumpDocumentsToFile(ctx, "movies", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(2500), written)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	assert.Len(t, lines, 2500)
+	assert.Equal(t, `{"id":0}`, lines[0])
+	assert.Equal(t, `{"id":2499}`, lines[2499])
+
+	path = filepath.Join(t.TempDir(), "cancelled.ndjson")
+	written, err = c.DumpDocumentsToFile(ctx, "cancelled", path)
+	assert.True(t, errors.Is(err, context.Canceled), "got %v", err)
+	assert.Zero(t, written)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "the partial file should be removed")
+}
+
+func TestClient_ApplySettingsFromReader(t *testing.T) {
+	var calls int
+	var body string
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"updateId":12}`))
+	})
+
+	updateID, err := c.ApplySettingsFromReader("movies", strings.NewReader(`{
+		"rankingRules": ["words", "typo", "release_date:desc"],
+		"searchableAttributes": ["title", "overview"],
+		"synonyms": {"sf": ["science fiction"]},
+		"typoTolerance": {"disableOnAttributes": ["sku"]}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(12), updateID.UpdateID)
+	assert.JSONEq(t, `{"rankingRules":["words","typo","release_date:desc"],"searchableAttributes":["title","overview"],`+
+		`"synonyms":{"sf":["science fiction"]},"typoTolerance":{"disableOnAttributes":["sku"]}}`, body)
+
+	_, err = c.ApplySettingsFromReader("movies", strings.NewReader(`{"rankingRules":["words"],"stopWord":["a"],"synonym":{}}`))
+	assert.EqualError(t, err, `unknown settings "stopWord", "synonym"`)
+
+	_, err = c.ApplySettingsFromReader("movies", strings.NewReader(`["words"]`))
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls, "invalid settings should not be sent")
+}
+
+func TestClient_CompressRequest(t *testing.T) {
+	var contentEncoding, body string
+	c := newMockClient(t, Config{CompressRequest: true}, func(w http.ResponseWriter, r *http.Request) {
+		contentEncoding = r.Header.Get("Content-Encoding")
+		var reader io.Reader = r.Body
+		if contentEncoding == "gzip" {
+			gz, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			reader = gz
+		}
+		raw, _ := ioutil.ReadAll(reader)
+		body = string(raw)
+
+		response := `{"updateId":1}`
+		status := http.StatusAccepted
+		if r.URL.Path == "/indexes/missing/documents" {
+			response, status = `{"message":"Index missing not found"}`, http.StatusNotFound
+		}
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(status)
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(response))
+		_ = gz.Close()
+	})
+
+	documents := []map[string]interface{}{{"id": 1, "title": strings.Repeat("verbose ", 100)}}
+	updateID, err := c.Documents("movies").AddOrReplace(documents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1), updateID.UpdateID, "the gzip response should be decompressed")
+	assert.Equal(t, "gzip", contentEncoding)
+	assert.JSONEq(t, `[{"id":1,"title":"`+strings.Repeat("verbose ", 100)+`"}]`, body)
+
+	if _, err := c.Documents("movies").AddOrReplace("id,title\n1,Nestle\n", WithCSV()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "gzip", contentEncoding)
+	assert.Equal(t, "id,title\n1,Nestle\n", body)
+
+	if _, err := c.Documents("movies").AddOrReplaceNDJSON(strings.NewReader(`{"id":1}` + "\n")); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "gzip", contentEncoding)
+	assert.Equal(t, `{"id":1}`+"\n", body)
+
+	_, err = c.Documents("missing").AddOrReplace(documents)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*Error).StatusCode)
+		assert.Equal(t, "Index missing not found", err.(*Error).MeilisearchMessage)
+	}
+
+	c.config.CompressRequest = false
+	if _, err := c.Documents("movies").AddOrReplace(documents); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "", contentEncoding)
+}
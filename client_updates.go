@@ -3,6 +3,7 @@ package meilisearch
 import (
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type clientUpdates struct {
@@ -61,3 +62,60 @@ func (c clientUpdates) IndexID() string {
 func (c clientUpdates) Client() ClientInterface {
 	return c.client
 }
+
+// task is a task as returned by the /tasks endpoint of the MeiliSearch versions which replaced the updates by tasks
+type task struct {
+	UID        int64        `json:"uid"`
+	Status     UpdateStatus `json:"status"`
+	Type       string       `json:"type"`
+	Error      *apiMessage  `json:"error"`
+	EnqueuedAt time.Time    `json:"enqueuedAt"`
+	FinishedAt *time.Time   `json:"finishedAt"`
+	BatchUID   *int64       `json:"batchUid"`
+}
+
+// update returns the task as the Update it replaces.
+func (t task) update() *Update {
+	update := &Update{
+		Status:     t.Status,
+		UpdateID:   t.UID,
+		Type:       Unknown{"name": t.Type},
+		EnqueuedAt: t.EnqueuedAt,
+		BatchUID:   t.BatchUID,
+	}
+	if t.Error != nil {
+		update.Error = t.Error.Message
+	}
+	if t.FinishedAt != nil {
+		update.ProcessedAt = *t.FinishedAt
+	}
+	return update
+}
+
+// getTask gets the task uid as an Update. Tasks are not bound to an index, e.g. a swap of indexes.
+func (c *Client) getTask(uid int64) (*Update, error) {
+	resp := &task{}
+	req := internalRequest{
+		endpoint:            "/tasks/" + strconv.FormatInt(uid, 10),
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetTask",
+		apiName:             "Updates",
+	}
+
+	if err := c.executeRequest(req); err != nil {
+		return nil, err
+	}
+
+	return resp.update(), nil
+}
+
+// getUpdate gets updateID, from the tasks if it is a task or from the updates of the indexID index otherwise.
+func (c *Client) getUpdate(indexID string, updateID *AsyncUpdateID) (*Update, error) {
+	if updateID.IsTask {
+		return c.getTask(updateID.UpdateID)
+	}
+	return c.Updates(indexID).Get(updateID.UpdateID)
+}
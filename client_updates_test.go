@@ -1,7 +1,10 @@
 package meilisearch
 
 import (
+	"net/http"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientUpdates_List(t *testing.T) {
@@ -19,3 +22,17 @@ func TestClientUpdates_List(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClientUpdates_Get_BatchUID(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"succeeded","updateId":3,"batchUid":12}`))
+	})
+
+	update, err := c.Updates("movies").Get(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, update.BatchUID) {
+		assert.Equal(t, int64(12), *update.BatchUID)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/pkg/errors"
+	"sort"
 	"strings"
 )
 
@@ -27,6 +28,10 @@ const (
 	ErrCodeResponseUnmarshalBody
 	// ErrCodeURLParsing impossible to parse url parameters
 	ErrCodeURLParsing
+	// ErrCodeResponseBodyTooLarge the response body exceeds Config.MaxResponseBodySize
+	ErrCodeResponseBodyTooLarge
+	// ErrCodeRequestValidation the request has been rejected by the client before being sent
+	ErrCodeRequestValidation
 )
 
 const (
@@ -37,6 +42,9 @@ const (
 	rawStringResponseStatusCode    = `unaccepted status code found: ${statusCode} expected: ${statusCodeExpected}, message from api: '${meilisearchMessage}', request: ${request}`
 	rawStringResponseReadBody      = `unable to read body from response: '${response}'`
 	rawStringResponseUnmarshalBody = `unable to unmarshal body from response: '${response}' status code: ${statusCode}`
+	rawStringResponseBodyTooLarge  = `response body exceeds the maximum allowed size`
+	rawStringRequestValidation     = `invalid request`
+	rawStringURLParsing            = `unable to parse url`
 )
 
 func (e ErrCode) rawMessage() string {
@@ -54,13 +62,37 @@ func (e ErrCode) rawMessage() string {
 		return rawStringResponseReadBody + " " + rawStringCtx
 	case ErrCodeResponseUnmarshalBody:
 		return rawStringResponseUnmarshalBody + " " + rawStringCtx
+	case ErrCodeResponseBodyTooLarge:
+		return rawStringResponseBodyTooLarge + " " + rawStringCtx
+	case ErrCodeRequestValidation:
+		return rawStringRequestValidation + " " + rawStringCtx
+	case ErrCodeURLParsing:
+		return rawStringURLParsing + " " + rawStringCtx
 	default:
 		return rawStringCtx
 	}
 }
 
+// ErrIndexUnavailable matches, with errors.Is, the errors returned when the index does not exist, e.g. because it
+// has been deleted while it was used.
+var ErrIndexUnavailable = errors.New("index unavailable")
+
+// ErrPaginationLimitReached is returned by the helpers paging through search results when the next page would be
+// beyond the maxTotalHits pagination setting of the index: MeiliSearch does not return these hits.
+var ErrPaginationLimitReached = errors.New("pagination limit reached: maxTotalHits of the index is exceeded")
+
+// ErrIncompleteResponse matches, with errors.Is, the errors returned when the connection has been closed before the
+// whole response has been received. Unlike a malformed response, the request can be sent again, see Config.Retry.
+var ErrIncompleteResponse = errors.New("incomplete response: the connection was closed before the end of the body")
+
+// meilisearchCodeIndexNotFound is the MeiliSearch error code of a missing index
+const meilisearchCodeIndexNotFound = "index_not_found"
+
 type apiMessage struct {
 	Message string `json:"message"`
+	// ErrorCode is the error code of MeiliSearch before v0.25, Code the one since
+	ErrorCode string `json:"errorCode"`
+	Code      string `json:"code"`
 }
 
 // Error is the internal error structure that all exposed method use.
@@ -87,6 +119,9 @@ type Error struct {
 	// MeilisearchMessage is the raw request into string ('empty meilisearch message' if not present)
 	MeilisearchMessage string
 
+	// MeilisearchCode is the error code returned by MeiliSearch, e.g. "index_not_found" (empty if not present)
+	MeilisearchCode string
+
 	// StatusCode of the request
 	StatusCode int
 
@@ -152,12 +187,67 @@ func (e *Error) ErrorBody(body []byte) {
 	err := json.Unmarshal(body, &msg)
 	if err == nil {
 		e.MeilisearchMessage = msg.Message
+		e.MeilisearchCode = msg.Code
+		if e.MeilisearchCode == "" {
+			e.MeilisearchCode = msg.ErrorCode
+		}
 	}
 }
 
+// Is allows matching the error with errors.Is, the error is ErrIndexUnavailable when MeiliSearch reported
+// that the index does not exist.
+func (e Error) Is(target error) bool {
+	return target == ErrIndexUnavailable && e.MeilisearchCode == meilisearchCodeIndexNotFound
+}
+
+// Unwrap returns OriginError, so that errors.Is and errors.As reach the cause of the error, e.g.
+// context.Canceled or a network error.
+func (e Error) Unwrap() error {
+	return e.OriginError
+}
+
 func namedSprintf(format string, params map[string]interface{}) string {
 	for key, val := range params {
 		format = strings.ReplaceAll(format, "${"+key+"}", fmt.Sprintf("%v", val))
 	}
 	return format
 }
+
+// IndexErrors is returned by the methods working on several indexes at once when some of them failed.
+// It maps the uid of each failed index to its error.
+type IndexErrors map[string]error
+
+// Error return the errors of every failed index, sorted by index uid.
+func (e IndexErrors) Error() string {
+	uids := make([]string, 0, len(e))
+	for uid := range e {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+
+	messages := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		messages = append(messages, uid+": "+e[uid].Error())
+	}
+	return fmt.Sprintf("%d index(es) failed: %s", len(e), strings.Join(messages, "; "))
+}
+
+// RollbackError is returned when an update failed and the previous state has been restored.
+// RollbackErr is nil if the restoration succeeded.
+type RollbackError struct {
+	Err         error
+	RollbackErr error
+}
+
+// Error return the error of the update and, if any, the one of the rollback.
+func (e RollbackError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("update failed: %s; rollback failed: %s", e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("update failed and has been rolled back: %s", e.Err)
+}
+
+// Unwrap returns Err, so that errors.Is and errors.As reach the error of the update.
+func (e RollbackError) Unwrap() error {
+	return e.Err
+}
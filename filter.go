@@ -0,0 +1,111 @@
+package meilisearch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// filterAttributes returns the attributes a filter expression, such as `genre = comedy AND (year > 1990 OR
+// director IN ["Nolan", "Villeneuve"])`, is about, in the order they first appear. The values and the _geo
+// functions are left out. The filter is not checked to be valid, an invalid one still gives its attributes.
+func filterAttributes(filter string) []string {
+	var attributes []string
+	seen := map[string]bool{}
+	expectAttribute := true
+	tokens := filterTokens(filter)
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch {
+		case token == "(":
+			expectAttribute = true
+		case token == "[":
+			// the values of IN
+			for i < len(tokens) && tokens[i] != "]" {
+				i++
+			}
+		case strings.EqualFold(token, "AND") || strings.EqualFold(token, "OR"):
+			expectAttribute = true
+		case !expectAttribute || token == ")" || strings.EqualFold(token, "NOT"):
+		case strings.HasPrefix(token, "_geo"):
+			// _geoRadius(lat, lng, distance) and the like, whose arguments are not attributes
+			if i+1 < len(tokens) && tokens[i+1] == "(" {
+				for i < len(tokens) && tokens[i] != ")" {
+					i++
+				}
+			}
+			expectAttribute = false
+		default:
+			attribute := unquoteFilterToken(token)
+			if !seen[attribute] {
+				seen[attribute] = true
+				attributes = append(attributes, attribute)
+			}
+			expectAttribute = false
+		}
+	}
+	return attributes
+}
+
+// filterTokens splits a filter expression into words, quoted strings, operators such as != and brackets.
+func filterTokens(filter string) []string {
+	var tokens []string
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '"' || r == '\'':
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' {
+					i++
+				}
+			}
+			i++
+		case strings.ContainsRune("()[],", r):
+			i++
+		case strings.ContainsRune("=!<>", r):
+			for i < len(runes) && strings.ContainsRune("=!<>", runes[i]) {
+				i++
+			}
+		default:
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("\"'()[],=!<>", runes[i]) {
+				i++
+			}
+		}
+		if i > len(runes) {
+			i = len(runes)
+		}
+		tokens = append(tokens, string(runes[start:i]))
+	}
+	return tokens
+}
+
+// unquoteFilterToken removes the quotes around a quoted token, and the escaping of the quotes inside it.
+func unquoteFilterToken(token string) string {
+	if len(token) < 2 || (token[0] != '"' && token[0] != '\'') || token[len(token)-1] != token[0] {
+		return token
+	}
+	quote := token[:1]
+	return strings.ReplaceAll(token[1:len(token)-1], `\`+quote, quote)
+}
+
+// notFilterable returns the attributes of the filter which are neither one of filterable nor nested in one of them.
+func notFilterable(filter string, filterable []string) []string {
+	var attributes []string
+	for _, attribute := range filterAttributes(filter) {
+		allowed := false
+		for _, f := range filterable {
+			if f == attribute || strings.HasPrefix(attribute, f+".") {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,37 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterAttributes(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: "", want: nil},
+		{filter: "genre = comedy", want: []string{"genre"}},
+		{filter: "year>1990 AND rating<=4.5", want: []string{"year", "rating"}},
+		{filter: `genre = "science fiction" OR (year > 1990 AND NOT director = 'Nolan')`,
+			want: []string{"genre", "year", "director"}},
+		{filter: `genre IN ["comedy", "drama"] and year 1990 TO 2000`, want: []string{"genre", "year"}},
+		{filter: "genre NOT IN [comedy] OR poster IS NOT NULL OR release.year EXISTS", want: []string{"genre", "poster", "release.year"}},
+		{filter: "_geoRadius(45.47, 9.18, 2000) AND genre != comedy AND genre != drama", want: []string{"genre"}},
+		{filter: `"release date" > 1990 AND title = "AND OR (" `, want: []string{"release date", "title"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			assert.Equal(t, tt.want, filterAttributes(tt.filter))
+		})
+	}
+}
+
+func TestNotFilterable(t *testing.T) {
+	filterable := []string{"genre", "release"}
+	assert.Empty(t, notFilterable("genre = comedy AND release.year > 1990", filterable))
+	assert.Equal(t, []string{"director", "releaseDate"},
+		notFilterable("genre = comedy AND (director = Nolan OR releaseDate > 1990)", filterable))
+	assert.Equal(t, []string{"genre"}, notFilterable("genre = comedy", nil))
+}
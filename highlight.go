@@ -0,0 +1,81 @@
+package meilisearch
+
+import (
+	"unicode/utf8"
+)
+
+// HighlightSpans converts the _matchesInfo positions of a hit into byte spans of text.
+//
+// MeiliSearch may report positions either in bytes or in characters depending on the version, which
+// breaks naive slicing of multibyte text (accented letters, CJK...). The unit parameter tells how
+// matches must be read. Returned spans are always aligned on UTF-8 character boundaries and clamped
+// to the text, so text[span.Start:span.End] is always valid. Empty matches are dropped.
+func HighlightSpans(text string, matches []MatchPosition, unit MatchesUnit) []HighlightSpan {
+	spans := make([]HighlightSpan, 0, len(matches))
+
+	var offsets []int
+	if unit == MatchesUnitChars {
+		offsets = runeOffsets(text)
+	}
+
+	for _, match := range matches {
+		if match.Start < 0 || match.Length <= 0 {
+			continue
+		}
+
+		var start, end int
+		if unit == MatchesUnitChars {
+			start = charToByteOffset(offsets, match.Start)
+			end = charToByteOffset(offsets, match.Start+match.Length)
+		} else {
+			start = alignStart(text, clampOffset(match.Start, len(text)))
+			end = alignEnd(text, clampOffset(match.Start+match.Length, len(text)))
+		}
+
+		if start >= end {
+			continue
+		}
+		spans = append(spans, HighlightSpan{Start: start, End: end})
+	}
+
+	return spans
+}
+
+// runeOffsets returns the byte offset of each character of text, plus len(text) as last element.
+func runeOffsets(text string) []int {
+	offsets := make([]int, 0, len(text)+1)
+	for i := range text {
+		offsets = append(offsets, i)
+	}
+	return append(offsets, len(text))
+}
+
+func charToByteOffset(offsets []int, char int64) int {
+	if char >= int64(len(offsets)) {
+		return offsets[len(offsets)-1]
+	}
+	return offsets[char]
+}
+
+func clampOffset(offset int64, length int) int {
+	if offset > int64(length) {
+		return length
+	}
+	return int(offset)
+}
+
+// alignStart moves offset backward to the beginning of the character it falls into.
+func alignStart(text string, offset int) int {
+	for offset > 0 && offset < len(text) && !utf8.RuneStart(text[offset]) {
+		offset--
+	}
+	return offset
+}
+
+// alignEnd moves offset forward to the end of the character it falls into.
+func alignEnd(text string, offset int) int {
+	for offset < len(text) && !utf8.RuneStart(text[offset]) {
+		offset++
+	}
+	return offset
+}
@@ -0,0 +1,92 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlightSpans(t *testing.T) {
+	type args struct {
+		text    string
+		matches []MatchPosition
+		unit    MatchesUnit
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  []HighlightSpan
+		parts []string
+	}{
+		{
+			name: "ascii bytes",
+			args: args{
+				text:    "Le Petit Prince",
+				matches: []MatchPosition{{Start: 9, Length: 6}},
+				unit:    MatchesUnitBytes,
+			},
+			want:  []HighlightSpan{{Start: 9, End: 15}},
+			parts: []string{"Prince"},
+		},
+		{
+			name: "accented chars",
+			args: args{
+				text:    "Crème brûlée",
+				matches: []MatchPosition{{Start: 6, Length: 6}},
+				unit:    MatchesUnitChars,
+			},
+			want:  []HighlightSpan{{Start: 7, End: 15}},
+			parts: []string{"brûlée"},
+		},
+		{
+			name: "accented bytes",
+			args: args{
+				text:    "Crème brûlée",
+				matches: []MatchPosition{{Start: 7, Length: 8}},
+				unit:    MatchesUnitBytes,
+			},
+			want:  []HighlightSpan{{Start: 7, End: 15}},
+			parts: []string{"brûlée"},
+		},
+		{
+			name: "cjk chars",
+			args: args{
+				text:    "東京は日本の首都です",
+				matches: []MatchPosition{{Start: 0, Length: 2}, {Start: 3, Length: 2}},
+				unit:    MatchesUnitChars,
+			},
+			want:  []HighlightSpan{{Start: 0, End: 6}, {Start: 9, End: 15}},
+			parts: []string{"東京", "日本"},
+		},
+		{
+			name: "bytes in the middle of a character are aligned",
+			args: args{
+				text:    "東京は日本",
+				matches: []MatchPosition{{Start: 1, Length: 4}},
+				unit:    MatchesUnitBytes,
+			},
+			want:  []HighlightSpan{{Start: 0, End: 6}},
+			parts: []string{"東京"},
+		},
+		{
+			name: "out of range and empty matches",
+			args: args{
+				text:    "Alice",
+				matches: []MatchPosition{{Start: 2, Length: 10}, {Start: 10, Length: 2}, {Start: 0, Length: 0}},
+				unit:    MatchesUnitChars,
+			},
+			want:  []HighlightSpan{{Start: 2, End: 5}},
+			parts: []string{"ice"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HighlightSpans(tt.args.text, tt.args.matches, tt.args.unit)
+			assert.Equal(t, tt.want, got)
+
+			for i, span := range got {
+				assert.Equal(t, tt.parts[i], tt.args.text[span.Start:span.End])
+			}
+		})
+	}
+}
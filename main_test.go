@@ -1,6 +1,8 @@
 package meilisearch
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 )
@@ -34,6 +36,16 @@ var client = NewClient(Config{
 	APIKey: "masterKey",
 })
 
+// newMockClient starts a stub MeiliSearch server running handler and returns a client using it.
+// The server is closed at the end of the test.
+func newMockClient(t *testing.T, config Config, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config.Host = server.URL
+	return NewClient(config).(*Client)
+}
+
 func TestMain(m *testing.M) {
 	_, _ = deleteAllIndexes(client)
 	code := m.Run()
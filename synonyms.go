@@ -0,0 +1,98 @@
+package meilisearch
+
+import (
+	"sort"
+	"strings"
+)
+
+// Synonyms maps a term to its synonyms, as sent by APISettings.UpdateSynonyms.
+type Synonyms map[string][]string
+
+// NormalizeOptions configures Synonyms.Normalize
+type NormalizeOptions struct {
+	// StripDiacritics replaces the latin letters with diacritics by their base letter, e.g. "é" by "e".
+	StripDiacritics bool
+}
+
+// Normalize returns a copy of the synonyms with all terms lowercased and trimmed, so that they match the way the
+// documents are searched. The terms which become duplicates are merged, a synonym being kept once in the
+// order it first appears. Empty terms are dropped.
+func (s Synonyms) Normalize(opts NormalizeOptions) Synonyms {
+	normalize := func(term string) string {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if opts.StripDiacritics {
+			term = stripDiacritics(term)
+		}
+		return term
+	}
+
+	terms := make([]string, 0, len(s))
+	for term := range s {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	normalized := Synonyms{}
+	seen := map[string]map[string]bool{}
+	for _, term := range terms {
+		key := normalize(term)
+		if key == "" {
+			continue
+		}
+		if seen[key] == nil {
+			seen[key] = map[string]bool{key: true}
+			normalized[key] = []string{}
+		}
+		for _, synonym := range s[term] {
+			synonym = normalize(synonym)
+			if synonym == "" || seen[key][synonym] {
+				continue
+			}
+			seen[key][synonym] = true
+			normalized[key] = append(normalized[key], synonym)
+		}
+	}
+	return normalized
+}
+
+// diacritics maps the lowercase latin letters with diacritics to their base letter.
+var diacritics = func() map[rune]rune {
+	letters := map[rune]string{
+		'a': "àáâãäåāăą",
+		'c': "çćĉċč",
+		'd': "ďđ",
+		'e': "èéêëēĕėęě",
+		'g': "ĝğġģ",
+		'h': "ĥħ",
+		'i': "ìíîïĩīĭįı",
+		'j': "ĵ",
+		'k': "ķ",
+		'l': "ĺļľŀł",
+		'n': "ñńņňŉ",
+		'o': "òóôõöøōŏő",
+		'r': "ŕŗř",
+		's': "śŝşš",
+		't': "ţťŧ",
+		'u': "ùúûüũūŭůűų",
+		'w': "ŵ",
+		'y': "ýÿŷ",
+		'z': "źżž",
+	}
+
+	table := map[rune]rune{}
+	for base, variants := range letters {
+		for _, variant := range variants {
+			table[variant] = base
+		}
+	}
+	return table
+}()
+
+func stripDiacritics(term string) string {
+	return strings.Map(func(r rune) rune {
+		if base, ok := diacritics[r]; ok {
+			return base
+		}
+		return r
+	}, term)
+}
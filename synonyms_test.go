@@ -0,0 +1,47 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynonyms_Normalize(t *testing.T) {
+	synonyms := Synonyms{
+		"Wolverine":  {"Logan ", " xmen", "logan", "WOLVERINE"},
+		"wolverine ": {"James Howlett", "xmen"},
+		"Café":       {"coffee", "Crème"},
+		"  ":         {"nothing"},
+		"empty":      {"", " "},
+	}
+
+	tests := []struct {
+		name     string
+		opts     NormalizeOptions
+		expected Synonyms
+	}{
+		{
+			name: "case folding, trimming and dedup",
+			expected: Synonyms{
+				"wolverine": {"logan", "xmen", "james howlett"},
+				"café":      {"coffee", "crème"},
+				"empty":     {},
+			},
+		},
+		{
+			name: "strip diacritics",
+			opts: NormalizeOptions{StripDiacritics: true},
+			expected: Synonyms{
+				"wolverine": {"logan", "xmen", "james howlett"},
+				"cafe":      {"coffee", "creme"},
+				"empty":     {},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, synonyms.Normalize(tt.opts))
+		})
+	}
+	assert.Equal(t, []string{"Logan ", " xmen", "logan", "WOLVERINE"}, synonyms["Wolverine"], "the synonyms should not be changed")
+}
@@ -0,0 +1,192 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RenderDocumentTemplate renders an embedder document template for doc, the same way MeiliSearch does before
+// computing the embedding of a document. It allows checking a template without indexing anything.
+//
+// The supported subset of the Liquid syntax is:
+//   - {{ doc.attribute }} and {{ doc.nested.attribute }} print a field of the document
+//   - {% for field in fields %} ... {% endfor %} iterates over the fields of the document by name,
+//     {{ field.name }} and {{ field.value }} printing the current one
+//
+// As in Liquid, a missing field renders as an empty string. Any other tag or variable is an error.
+func RenderDocumentTemplate(template string, doc map[string]interface{}) (string, error) {
+	nodes, _, err := parseTemplate(template, false)
+	if err != nil {
+		return "", err
+	}
+
+	builder := &strings.Builder{}
+	if err := renderTemplate(builder, nodes, templateScope{doc: doc}); err != nil {
+		return "", err
+	}
+	return builder.String(), nil
+}
+
+type templateNode struct {
+	text     string
+	variable string
+	loop     []templateNode
+	isLoop   bool
+}
+
+type templateScope struct {
+	doc   map[string]interface{}
+	field *templateField
+}
+
+type templateField struct {
+	name  string
+	value interface{}
+}
+
+// parseTemplate parses template until its end or, when inLoop, until {% endfor %}. It returns the remaining
+// template after the {% endfor %}.
+func parseTemplate(template string, inLoop bool) (nodes []templateNode, rest string, err error) {
+	for template != "" {
+		start := nextTemplateTag(template)
+		if start == -1 {
+			return append(nodes, templateNode{text: template}), "", checkLoopEnd(inLoop)
+		}
+		if start > 0 {
+			nodes = append(nodes, templateNode{text: template[:start]})
+		}
+
+		closing := "}}"
+		if template[start+1] == '%' {
+			closing = "%}"
+		}
+		end := strings.Index(template[start+2:], closing)
+		if end == -1 {
+			return nil, "", errors.Errorf("unclosed %s in %q", template[start:start+2], template[start:])
+		}
+		content := strings.TrimSpace(template[start+2 : start+2+end])
+		template = template[start+2+end+2:]
+
+		if closing == "}}" {
+			nodes = append(nodes, templateNode{variable: content})
+			continue
+		}
+
+		switch content {
+		case "for field in fields":
+			var loop []templateNode
+			loop, template, err = parseTemplate(template, true)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, templateNode{loop: loop, isLoop: true})
+		case "endfor":
+			if !inLoop {
+				return nil, "", errors.New("unexpected {% endfor %}")
+			}
+			return nodes, template, nil
+		default:
+			return nil, "", errors.Errorf("unsupported tag {%% %s %%}", content)
+		}
+	}
+	return nodes, "", checkLoopEnd(inLoop)
+}
+
+// nextTemplateTag returns the offset of the first {{ or {% of template, or -1 if there is none.
+func nextTemplateTag(template string) int {
+	output := strings.Index(template, "{{")
+	tag := strings.Index(template, "{%")
+	if output == -1 || (tag != -1 && tag < output) {
+		return tag
+	}
+	return output
+}
+
+func checkLoopEnd(inLoop bool) error {
+	if inLoop {
+		return errors.New("missing {% endfor %}")
+	}
+	return nil
+}
+
+func renderTemplate(builder *strings.Builder, nodes []templateNode, scope templateScope) error {
+	for _, node := range nodes {
+		switch {
+		case node.isLoop:
+			names := make([]string, 0, len(scope.doc))
+			for name := range scope.doc {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				loopScope := scope
+				loopScope.field = &templateField{name: name, value: scope.doc[name]}
+				if err := renderTemplate(builder, node.loop, loopScope); err != nil {
+					return err
+				}
+			}
+		case node.variable != "":
+			value, err := scope.lookup(node.variable)
+			if err != nil {
+				return err
+			}
+			builder.WriteString(formatTemplateValue(value))
+		default:
+			builder.WriteString(node.text)
+		}
+	}
+	return nil
+}
+
+func (s templateScope) lookup(variable string) (interface{}, error) {
+	path := strings.Split(variable, ".")
+
+	switch path[0] {
+	case "doc":
+		var value interface{} = s.doc
+		for _, key := range path[1:] {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+			value = object[key]
+		}
+		return value, nil
+	case "field":
+		if s.field == nil {
+			return nil, errors.Errorf("{{ %s }} used outside of a for loop", variable)
+		}
+		switch variable {
+		case "field.name":
+			return s.field.name, nil
+		case "field.value":
+			return s.field.value, nil
+		}
+	}
+	return nil, errors.Errorf("unknown variable {{ %s }}", variable)
+}
+
+func formatTemplateValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool, int, int64, json.Number:
+		return fmt.Sprint(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
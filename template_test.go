@@ -0,0 +1,78 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderDocumentTemplate(t *testing.T) {
+	doc := map[string]interface{}{
+		"title":  "Le Petit Prince",
+		"year":   float64(1943),
+		"author": map[string]interface{}{"name": "Antoine de Saint-Exupéry"},
+		"genres": []interface{}{"fable", "novella"},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "field substitution",
+			template: "A book titled '{{doc.title}}' published in {{ doc.year }}",
+			want:     "A book titled 'Le Petit Prince' published in 1943",
+		},
+		{
+			name:     "nested field",
+			template: "by {{ doc.author.name }}",
+			want:     "by Antoine de Saint-Exupéry",
+		},
+		{
+			name:     "missing field",
+			template: "[{{ doc.overview }}][{{ doc.author.birth }}][{{ doc.title.length }}]",
+			want:     "[][][]",
+		},
+		{
+			name:     "array field",
+			template: "{{ doc.genres }}",
+			want:     `["fable","novella"]`,
+		},
+		{
+			name:     "for loop over fields",
+			template: "{% for field in fields %}{{ field.name }}={{ field.value }};{% endfor %}",
+			want:     `author={"name":"Antoine de Saint-Exupéry"};genres=["fable","novella"];title=Le Petit Prince;year=1943;`,
+		},
+		{
+			name:     "no tag",
+			template: "a {single} brace",
+			want:     "a {single} brace",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderDocumentTemplate(tt.template, doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderDocumentTemplate_Errors(t *testing.T) {
+	templates := []string{
+		"{{ doc.title",
+		"{% if doc.title %}{{ doc.title }}{% endif %}",
+		"{% for field in fields %}{{ field.name }}",
+		"{{ field.name }}{% endfor %}",
+		"{{ field.name }}",
+		"{{ title }}",
+	}
+	for _, template := range templates {
+		if _, err := RenderDocumentTemplate(template, map[string]interface{}{"title": "Alice"}); err == nil {
+			t.Fatalf("%q should not render", template)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package meilisearch
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TenantTokenOptions configures GenerateTenantToken
+type TenantTokenOptions struct {
+	// APIKey is the value of the key whose uid is given to GenerateTenantToken, the token is signed with it.
+	APIKey string
+
+	// ExpiresAt is when the token expires, the zero time means it never expires (as long as its key is valid).
+	ExpiresAt time.Time
+}
+
+// tenantTokenClaims is the payload of a tenant token
+type tenantTokenClaims struct {
+	SearchRules interface{} `json:"searchRules"`
+	APIKeyUID   string      `json:"apiKeyUid"`
+	ExpiresAt   int64       `json:"exp,omitempty"`
+}
+
+// tenantTokenHeader is the base64 encoded header of the tenant tokens
+var tenantTokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// GenerateTenantToken generates a tenant token, a JWT signed with HS256 which can be used as an API key to search
+// with the rules of searchRules enforced by MeiliSearch, e.g. to only return the documents of a user. The token is
+// generated locally, without any request to MeiliSearch.
+//
+// searchRules is either a list of the indexes which can be searched, e.g. []string{"movies"}, or a map of these
+// indexes to their search rules, e.g. map[string]interface{}{"movies": map[string]string{"filter": "user_id = 1"}}.
+// apiKeyUID is the uid of the key whose value is in options.APIKey, the key must be allowed to search.
+func GenerateTenantToken(apiKeyUID string, searchRules interface{}, options TenantTokenOptions) (string, error) {
+	if apiKeyUID == "" {
+		return "", errors.New("the uid of the API key is required")
+	}
+	if options.APIKey == "" {
+		return "", errors.New("the API key is required to sign the token")
+	}
+	switch searchRules.(type) {
+	case []string, map[string]interface{}:
+	default:
+		return "", errors.Errorf("search rules must be a []string or a map[string]interface{}, got %T", searchRules)
+	}
+	if !options.ExpiresAt.IsZero() && !options.ExpiresAt.After(time.Now()) {
+		return "", errors.Errorf("the expiration date %s is in the past", options.ExpiresAt)
+	}
+
+	claims := tenantTokenClaims{SearchRules: searchRules, APIKeyUID: apiKeyUID}
+	if !options.ExpiresAt.IsZero() {
+		claims.ExpiresAt = options.ExpiresAt.Unix()
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to encode the search rules")
+	}
+
+	unsigned := tenantTokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(options.APIKey))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,102 @@
+package meilisearch
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTenantToken(t *testing.T) {
+	const apiKey = "d0552b41536279a0ad88bd595327b96f01176a60c2243e906c52ac02375f9bc4"
+	const apiKeyUID = "01b4bc42-eb33-4041-b481-254d00cce834"
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name        string
+		searchRules interface{}
+		expiresAt   time.Time
+		want        string
+	}{
+		{
+			name:        "indexes",
+			searchRules: []string{"movies", "books"},
+			want:        `{"searchRules":["movies","books"],"apiKeyUid":"` + apiKeyUID + `"}`,
+		},
+		{
+			name:        "rules per index with expiration",
+			searchRules: map[string]interface{}{"movies": map[string]string{"filter": "user_id = 1"}},
+			expiresAt:   expiresAt,
+			want: `{"searchRules":{"movies":{"filter":"user_id = 1"}},"apiKeyUid":"` + apiKeyUID + `",` +
+				`"exp":` + strconv.FormatInt(expiresAt.Unix(), 10) + `}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := GenerateTenantToken(apiKeyUID, tt.searchRules, TenantTokenOptions{
+				APIKey:    apiKey,
+				ExpiresAt: tt.expiresAt,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parts := strings.Split(token, ".")
+			if len(parts) != 3 {
+				t.Fatalf("a JWT has 3 parts, got %q", token)
+			}
+
+			header, err := base64.RawURLEncoding.DecodeString(parts[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, `{"alg":"HS256","typ":"JWT"}`, string(header))
+
+			claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.JSONEq(t, tt.want, string(claims))
+
+			signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+			if err != nil {
+				t.Fatal(err)
+			}
+			mac := hmac.New(sha256.New, []byte(apiKey))
+			mac.Write([]byte(parts[0] + "." + parts[1]))
+			assert.True(t, hmac.Equal(mac.Sum(nil), signature), "the token should be signed with the API key")
+		})
+	}
+}
+
+func TestGenerateTenantToken_Invalid(t *testing.T) {
+	options := TenantTokenOptions{APIKey: "d0552b41"}
+	tests := []struct {
+		name        string
+		apiKeyUID   string
+		searchRules interface{}
+		options     TenantTokenOptions
+	}{
+		{name: "no uid", searchRules: []string{"*"}, options: options},
+		{name: "no key", apiKeyUID: "uid", searchRules: []string{"*"}},
+		{name: "invalid rules", apiKeyUID: "uid", searchRules: "movies", options: options},
+		{
+			name:        "expired",
+			apiKeyUID:   "uid",
+			searchRules: []string{"*"},
+			options:     TenantTokenOptions{APIKey: "d0552b41", ExpiresAt: time.Now().Add(-time.Minute)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := GenerateTenantToken(tt.apiKeyUID, tt.searchRules, tt.options)
+			assert.Error(t, err)
+			assert.Empty(t, token)
+		})
+	}
+}
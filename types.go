@@ -2,6 +2,7 @@ package meilisearch
 
 import (
 	"bytes"
+	"encoding/json"
 	"github.com/valyala/fastjson"
 	"sync"
 	"time"
@@ -27,7 +28,8 @@ type Index struct {
 	PrimaryKey string    `json:"primaryKey,omitempty"`
 }
 
-// Settings is the type that represents the settings in MeiliSearch
+// Settings is the type that represents the settings in MeiliSearch.
+// When updating, a nil DistinctAttribute is left unchanged and a pointer to "" resets it.
 type Settings struct {
 	RankingRules          []string            `json:"rankingRules,omitempty"`
 	DistinctAttribute     *string             `json:"distinctAttribute,omitempty"`
@@ -36,6 +38,63 @@ type Settings struct {
 	StopWords             []string            `json:"stopWords,omitempty"`
 	Synonyms              map[string][]string `json:"synonyms,omitempty"`
 	AttributesForFaceting []string            `json:"attributesForFaceting,omitempty"`
+	FilterableAttributes  []string            `json:"filterableAttributes,omitempty"`
+	SortableAttributes    []string            `json:"sortableAttributes,omitempty"`
+	Pagination            *Pagination         `json:"pagination,omitempty"`
+	TypoTolerance         *TypoTolerance      `json:"typoTolerance,omitempty"`
+	Faceting              *Faceting           `json:"faceting,omitempty"`
+}
+
+// Pagination is the type that represents the pagination settings in MeiliSearch.
+// MaxTotalHits is the maximum number of hits a search can return, whatever its offset and limit.
+type Pagination struct {
+	MaxTotalHits int64 `json:"maxTotalHits"`
+}
+
+// Faceting is the type that represents the faceting settings in MeiliSearch.
+// MaxValuesPerFacet is the maximum number of values returned for each facet by a search, 100 by default.
+type Faceting struct {
+	MaxValuesPerFacet int64 `json:"maxValuesPerFacet"`
+}
+
+// TypoTolerance is the type that represents the typo tolerance settings in MeiliSearch.
+// Enabled is a pointer so that an update can leave it unchanged, DisableOnAttributes allows no typos on
+// these attributes, e.g. product SKUs, and DisableOnWords allows no typos on these words. The lists are pointers
+// too, so that an update can either leave them unchanged or clear them with a pointer to an empty list.
+type TypoTolerance struct {
+	Enabled             *bool                `json:"enabled,omitempty"`
+	MinWordSizeForTypos *MinWordSizeForTypos `json:"minWordSizeForTypos,omitempty"`
+	DisableOnWords      *[]string            `json:"disableOnWords,omitempty"`
+	DisableOnAttributes *[]string            `json:"disableOnAttributes,omitempty"`
+}
+
+// MinWordSizeForTypos is the minimum length of the words on which one or two typos are allowed.
+type MinWordSizeForTypos struct {
+	OneTypo  int64 `json:"oneTypo,omitempty"`
+	TwoTypos int64 `json:"twoTypos,omitempty"`
+}
+
+// SynonymFormat is the format of the file read by ImportSynonymsFromReader
+type SynonymFormat int
+
+const (
+	// SynonymFormatCSV is for comma separated values
+	SynonymFormatCSV SynonymFormat = iota
+	// SynonymFormatTSV is for tab separated values
+	SynonymFormatTSV
+)
+
+// RelevanceConfigVersion is the version of the RelevanceConfig written by ExportRelevanceConfig
+const RelevanceConfigVersion = 1
+
+// RelevanceConfig is the bundle of the settings impacting relevancy written by ExportRelevanceConfig, to be
+// restored on another index or environment with ImportRelevanceConfig.
+type RelevanceConfig struct {
+	Version              int                 `json:"version"`
+	RankingRules         []string            `json:"rankingRules"`
+	Synonyms             map[string][]string `json:"synonyms"`
+	StopWords            []string            `json:"stopWords"`
+	SearchableAttributes []string            `json:"searchableAttributes"`
 }
 
 // Version is the type that represents the versions in MeiliSearch
@@ -45,11 +104,16 @@ type Version struct {
 	PkgVersion string    `json:"pkgVersion"`
 }
 
-// StatsIndex is the type that represent the stats of an index in MeiliSearch
+// StatsIndex is the type that represent the stats of an index in MeiliSearch.
+// Newer versions report the number of documents having each field in FieldDistribution instead of FieldsFrequency.
+// LastUpdate and RawDocumentDbSize are only reported by newer versions, LastUpdate is nil otherwise.
 type StatsIndex struct {
 	NumberOfDocuments int64            `json:"numberOfDocuments"`
 	IsIndexing        bool             `json:"isIndexing"`
 	FieldsFrequency   map[string]int64 `json:"fieldsFrequency"`
+	FieldDistribution map[string]int64 `json:"fieldDistribution,omitempty"`
+	LastUpdate        *time.Time       `json:"lastUpdate,omitempty"`
+	RawDocumentDbSize int64            `json:"rawDocumentDbSize,omitempty"`
 }
 
 // Stats is the type that represent all stats
@@ -71,8 +135,20 @@ const (
 	UpdateStatusProcessed UpdateStatus = "processed"
 	// UpdateStatusFailed means the server has processed the update and an error has been reported
 	UpdateStatusFailed UpdateStatus = "failed"
+	// UpdateStatusProcessing means the server is handling the update, only reported by newer versions
+	UpdateStatusProcessing UpdateStatus = "processing"
+	// UpdateStatusSucceeded means the server has processed the update and all went well,
+	// newer versions report it instead of UpdateStatusProcessed
+	UpdateStatusSucceeded UpdateStatus = "succeeded"
+	// UpdateStatusCanceled means the update has been canceled before being processed
+	UpdateStatusCanceled UpdateStatus = "canceled"
 )
 
+// isPending tells if an update with this status has not been processed yet.
+func (s UpdateStatus) isPending() bool {
+	return s == UpdateStatusEnqueued || s == UpdateStatusProcessing
+}
+
 // Update indicate information about an update
 type Update struct {
 	Status      UpdateStatus `json:"status"`
@@ -81,13 +157,133 @@ type Update struct {
 	Error       string       `json:"error"`
 	EnqueuedAt  time.Time    `json:"enqueuedAt"`
 	ProcessedAt time.Time    `json:"processedAt"`
+	// BatchUID is the batch the update has been processed in, nil while enqueued or for older versions
+	BatchUID *int64 `json:"batchUid,omitempty"`
+}
+
+// WaitResult is the result of WaitForPendingUpdateAsync
+//
+//easyjson:skip
+type WaitResult struct {
+	Status UpdateStatus
+	Err    error
+}
+
+// RequestEvent describes an attempt of a request, as given to a RequestObserver.
+//
+//easyjson:skip
+type RequestEvent struct {
+	Method   string
+	Endpoint string
+	// Function is the method of the client sending the request, e.g. "Search.Search"
+	Function string
+	// Attempt is the number of the attempt, from 1, it is greater than 1 for the retries of Config.Retry
+	Attempt int
+	// Final is set on the last attempt of the request, Attempt being then the number of attempts made
+	Final bool
+	// StatusCode is the status code of the response, 0 if none has been received
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// BatchOutcome is the outcome of one of the updates waited by WaitForAll
+type BatchOutcome struct {
+	UpdateID int64        `json:"updateId"`
+	Status   UpdateStatus `json:"status"`
+	// Error is the error reported by MeiliSearch for a failed update, or the error which prevented knowing
+	// the status of the update
+	Error string `json:"error,omitempty"`
+}
+
+// Succeeded tells if the update has been processed without error
+func (o BatchOutcome) Succeeded() bool {
+	return (o.Status == UpdateStatusProcessed || o.Status == UpdateStatusSucceeded) && o.Error == ""
+}
+
+// BatchResult gathers the outcomes of the updates waited by WaitForAll, in the order of the update ids given
+type BatchResult struct {
+	Outcomes  []BatchOutcome `json:"outcomes"`
+	Succeeded int            `json:"succeeded"`
+	Failed    int            `json:"failed"`
+}
+
+// BatchStats gives the number of tasks of a batch, by status, type and index
+type BatchStats struct {
+	TotalNbTasks int64            `json:"totalNbTasks"`
+	Status       map[string]int64 `json:"status"`
+	Types        map[string]int64 `json:"types"`
+	IndexUIDs    map[string]int64 `json:"indexUids"`
+}
+
+// Batch is a group of tasks processed together by MeiliSearch
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/batches
+type Batch struct {
+	UID        int64      `json:"uid"`
+	Details    Unknown    `json:"details"`
+	Stats      BatchStats `json:"stats"`
+	Duration   string     `json:"duration"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+}
+
+// DumpStatus is the status of the creation of a dump.
+type DumpStatus string
+
+const (
+	// DumpStatusInProgress means the dump is being created
+	DumpStatusInProgress DumpStatus = "in_progress"
+	// DumpStatusDone means the dump has been created
+	DumpStatusDone DumpStatus = "done"
+	// DumpStatusFailed means the dump could not be created, the reason is given in the Error of the Dump
+	DumpStatusFailed DumpStatus = "failed"
+)
+
+// Dump indicate information about the creation of a dump
+//
+// Documentation: https://docs.meilisearch.com/references/dump.html
+type Dump struct {
+	UID        string     `json:"uid"`
+	Status     DumpStatus `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
 }
 
 // AsyncUpdateID is returned for asynchronous method
 //
 // Documentation: https://docs.meilisearch.com/guides/advanced_guides/asynchronous_updates.html
+//
+//easyjson:skip
 type AsyncUpdateID struct {
 	UpdateID int64 `json:"updateId"`
+	// IsTask tells that UpdateID is the uid of a task, whose status is at /tasks/:uid and not under the index
+	IsTask bool `json:"-"`
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface.
+// Newer MeiliSearch versions return the id of the enqueued task as taskUid or uid instead of updateId,
+// all of them are read into UpdateID and IsTask is set for the former.
+func (a *AsyncUpdateID) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		TaskUID  *int64 `json:"taskUid"`
+		UID      *int64 `json:"uid"`
+		UpdateID *int64 `json:"updateId"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch {
+	case raw.TaskUID != nil:
+		a.UpdateID, a.IsTask = *raw.TaskUID, true
+	case raw.UID != nil:
+		a.UpdateID, a.IsTask = *raw.UID, true
+	case raw.UpdateID != nil:
+		a.UpdateID = *raw.UpdateID
+	}
+	return nil
 }
 
 // Keys allow the user to connect to the MeiliSearch instance
@@ -98,10 +294,88 @@ type Keys struct {
 	Private string `json:"private,omitempty"`
 }
 
+// Key is an API key restricted to a set of actions on a set of indexes
+//
+// Documentation: https://docs.meilisearch.com/reference/api/keys.html
+type Key struct {
+	UID         string     `json:"uid,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Key         string     `json:"key,omitempty"`
+	Actions     []string   `json:"actions"`
+	Indexes     []string   `json:"indexes"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// KeysResults is the response body for list keys method.
+// Versions returning the keys as a plain array are supported too, Offset, Limit and Total are then left empty.
+//
+//easyjson:skip
+type KeysResults struct {
+	Results []Key `json:"results"`
+	Offset  int64 `json:"offset"`
+	Limit   int64 `json:"limit"`
+	Total   int64 `json:"total"`
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (k *KeysResults) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) != 0 && trimmed[0] == '[' {
+		*k = KeysResults{}
+		return json.Unmarshal(data, &k.Results)
+	}
+	type keysResults KeysResults
+	return json.Unmarshal(data, (*keysResults)(k))
+}
+
+// UpdateKeyRequest is the request body for update key method, the empty fields are left unchanged.
+type UpdateKeyRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+const (
+	// DiagnosticCheckHealth is the name of the check of the server health
+	DiagnosticCheckHealth = "health"
+	// DiagnosticCheckVersion is the name of the check getting the server version
+	DiagnosticCheckVersion = "version"
+	// DiagnosticCheckListIndexes is the name of the check listing the indexes, it fails when the API key
+	// is missing or not allowed to
+	DiagnosticCheckListIndexes = "listIndexes"
+)
+
+// DiagnosticCheck is the result of one of the checks of Diagnostics
+type DiagnosticCheck struct {
+	Name    string        `json:"name"`
+	OK      bool          `json:"ok"`
+	Latency time.Duration `json:"latency"`
+	// StatusCode is the status code of the failed request, 0 if the server has not been reached
+	StatusCode int    `json:"statusCode,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// DiagnosticsReport is the result of Diagnostics, OK is true when all checks passed
+type DiagnosticsReport struct {
+	OK      bool              `json:"ok"`
+	Version string            `json:"version,omitempty"`
+	Checks  []DiagnosticCheck `json:"checks"`
+}
+
 //
 // Request/Response
 //
 
+// BatchesResponse is the response body for list batches method
+type BatchesResponse struct {
+	Results []Batch `json:"results"`
+	Total   int64   `json:"total"`
+	Limit   int64   `json:"limit"`
+	From    *int64  `json:"from"`
+	Next    *int64  `json:"next"`
+}
+
 // CreateIndexRequest is the request body for create index method
 type CreateIndexRequest struct {
 	Name       string `json:"name,omitempty"`
@@ -109,18 +383,41 @@ type CreateIndexRequest struct {
 	PrimaryKey string `json:"primaryKey,omitempty"`
 }
 
-// CreateIndexResponse is the response body for create index method
+// CreateIndexResponse is the response body for create index method.
+// Newer MeiliSearch versions create the index asynchronously and only return the task creating it, TaskUID is
+// then set and the index fields are empty but UID.
 type CreateIndexResponse struct {
 	Name       string    `json:"name"`
 	UID        string    `json:"uid"`
 	UpdateID   int64     `json:"updateID,omitempty"`
+	TaskUID    *int64    `json:"taskUid,omitempty"`
 	CreatedAt  time.Time `json:"createdAt"`
 	UpdatedAt  time.Time `json:"updatedAt"`
 	PrimaryKey string    `json:"primaryKey,omitempty"`
 }
 
+// AsyncUpdateID returns the update of the index creation, to be given to WaitForPendingUpdate,
+// or nil if the index has been created synchronously.
+func (r CreateIndexResponse) AsyncUpdateID() *AsyncUpdateID {
+	if r.TaskUID == nil {
+		return nil
+	}
+	return &AsyncUpdateID{UpdateID: *r.TaskUID, IsTask: true}
+}
+
+// CreateKeyRequest is the request body for create key method.
+// A nil ExpiresAt is sent as null, meaning the key never expires.
+type CreateKeyRequest struct {
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Actions     []string   `json:"actions"`
+	Indexes     []string   `json:"indexes"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
+
 // SearchRequest is the request url param needed for a search query.
 // This struct will be converted to url param before sent.
+// A nil AttributesToRetrieve retrieves all the displayed attributes, an empty non-nil one none of them.
 //
 // Documentation: https://docs.meilisearch.com/guides/advanced_guides/search_parameters.html
 type SearchRequest struct {
@@ -136,25 +433,160 @@ type SearchRequest struct {
 	FacetsDistribution    []string
 	FacetFilters          interface{}
 	PlaceholderSearch     bool
+
+	// Filter is the filter expression, e.g. `genre = comedy AND year > 1990`, sent as filter to the versions from
+	// v0.21 on, which replaced filters. Filters is only read by the older versions.
+	Filter string
+
+	// Sort orders the hits by attributes, e.g. []string{"price:asc"}, instead of by relevancy.
+	// The attributes must be sortable attributes, see APISettings.UpdateSortableAttributes.
+	Sort []string
+
+	// RankingScoreThreshold, between 0 and 1, excludes the hits with a lower ranking score.
+	// Excluded hits are not counted in NbHits nor in the FacetsDistribution either.
+	RankingScoreThreshold *float64
+
+	// CountOnly only asks for the number of matching documents, returned in TotalHits, without any hit.
+	// It is sent as hitsPerPage 0 and replaces Offset and Limit.
+	CountOnly bool
+
+	// ExtraQueryParams are added to the url of the search, for parameters not supported by SearchRequest yet
+	ExtraQueryParams map[string]string
+
+	// HighlightPreTag and HighlightPostTag surround the highlighted terms instead of <em> and </em>, e.g. <mark>
+	// and </mark>. CropMarker marks the cropped text instead of "…".
+	HighlightPreTag  string
+	HighlightPostTag string
+	CropMarker       string
 }
 
 // SearchResponse is the response body for search method
+//
+//easyjson:skip
 type SearchResponse struct {
 	Hits                  []interface{} `json:"hits"`
 	NbHits                int64         `json:"nbHits"`
+	TotalHits             int64         `json:"totalHits,omitempty"`
+	SemanticHitCount      int64         `json:"semanticHitCount,omitempty"`
 	Offset                int64         `json:"offset"`
 	Limit                 int64         `json:"limit"`
 	ProcessingTimeMs      int64         `json:"processingTimeMs"`
 	Query                 string        `json:"query"`
 	FacetsDistribution    interface{}   `json:"facetsDistribution,omitempty"`
-	ExhaustiveFacetsCount interface{}   `json:"exhaustiveFacetsCount,omitempty"`
+	ExhaustiveFacetsCount bool          `json:"exhaustiveFacetsCount,omitempty"`
+
+	// EstimatedTotalHits is the estimated number of matching documents sent by newer versions instead of NbHits,
+	// TotalHits being sent instead when the number is exact.
+	EstimatedTotalHits int64 `json:"estimatedTotalHits,omitempty"`
+
+	// ExhaustiveHitsCount is set when the number of matching documents returned by HitsCount is exact: MeiliSearch
+	// sent totalHits, or nbHits along with exhaustiveNbHits true.
+	ExhaustiveHitsCount bool `json:"-"`
+
+	// Degraded is set when the hits are not coming from MeiliSearch but from the fallback given to
+	// SearchWithFallback
+	Degraded bool `json:"-"`
+
+	// rawHits are the hits as received, decoded by DecodeHits
+	rawHits RawType
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface.
+// The raw hits are kept so that DecodeHits decodes them directly into the caller's type.
+func (r *SearchResponse) UnmarshalJSON(data []byte) error {
+	type searchResponse SearchResponse
+	aux := struct {
+		*searchResponse
+		Hits             RawType `json:"hits"`
+		TotalHits        *int64  `json:"totalHits"`
+		ExhaustiveNbHits bool    `json:"exhaustiveNbHits"`
+	}{searchResponse: (*searchResponse)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.TotalHits = 0
+	if aux.TotalHits != nil {
+		r.TotalHits = *aux.TotalHits
+	}
+	r.ExhaustiveHitsCount = aux.TotalHits != nil || (aux.ExhaustiveNbHits && r.EstimatedTotalHits == 0)
+
+	r.Hits = nil
+	r.rawHits = nil
+	if len(aux.Hits) == 0 || string(aux.Hits) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(aux.Hits, &r.Hits); err != nil {
+		return err
+	}
+	r.rawHits = aux.Hits
+	return nil
+}
+
+// HitsCount returns the number of matching documents, whichever of totalHits, estimatedTotalHits and nbHits
+// MeiliSearch sent. ExhaustiveHitsCount tells whether it is exact.
+func (r *SearchResponse) HitsCount() int64 {
+	switch {
+	case r.TotalHits != 0:
+		return r.TotalHits
+	case r.EstimatedTotalHits != 0:
+		return r.EstimatedTotalHits
+	default:
+		return r.NbHits
+	}
+}
+
+// FacetSearchRequest is the request body for facet search method.
+// Query and Filter restrict the documents whose facet values are searched.
+type FacetSearchRequest struct {
+	FacetName  string      `json:"facetName"`
+	FacetQuery string      `json:"facetQuery,omitempty"`
+	Query      string      `json:"q,omitempty"`
+	Filter     interface{} `json:"filter,omitempty"`
+}
+
+// FacetHit is a facet value matching a facet search and the number of documents having it
+type FacetHit struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// FacetSearchResponse is the response body for facet search method
+type FacetSearchResponse struct {
+	FacetHits        []FacetHit `json:"facetHits"`
+	FacetQuery       string     `json:"facetQuery"`
+	ProcessingTimeMs int64      `json:"processingTimeMs"`
+}
+
+// MatchesUnit is the unit in which the positions of a MatchPosition are expressed.
+type MatchesUnit int
+
+const (
+	// MatchesUnitBytes means positions are byte offsets in the UTF-8 encoded text
+	MatchesUnitBytes MatchesUnit = iota
+	// MatchesUnitChars means positions are counted in characters (unicode code points)
+	MatchesUnitChars
+)
+
+// MatchPosition is one entry of the _matchesInfo returned for a hit when Matches is enabled
+type MatchPosition struct {
+	Start  int64 `json:"start"`
+	Length int64 `json:"length"`
+}
+
+// HighlightSpan is a byte range of a text, it can be used directly as text[Start:End]
+type HighlightSpan struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
 }
 
-// ListDocumentsRequest is the request body for list documents method
+// ListDocumentsRequest is the request body for list documents method.
+// IDs restricts the documents listed to the ones with these identifiers.
 type ListDocumentsRequest struct {
 	Offset               int64    `json:"offset,omitempty"`
 	Limit                int64    `json:"limit,omitempty"`
 	AttributesToRetrieve []string `json:"attributesToRetrieve,omitempty"`
+	IDs                  []string `json:"ids,omitempty"`
 }
 
 // RawType is an alias for raw byte[]
@@ -178,9 +610,11 @@ type PrimaryKey struct {
 // Unknown is unknown json type
 type Unknown map[string]interface{}
 
-// UnmarshalJSON supports json.Unmarshaler interface
+// UnmarshalJSON supports json.Unmarshaler interface.
+// data is copied as the response buffer it comes from is reused once the request is done. The copy is a new
+// buffer, so that a value copied out of b before is not overwritten.
 func (b *RawType) UnmarshalJSON(data []byte) error {
-	*b = data
+	*b = append(RawType(nil), data...)
 	return nil
 }
 
@@ -188,3 +622,66 @@ func (b *RawType) UnmarshalJSON(data []byte) error {
 func (b RawType) MarshalJSON() ([]byte, error) {
 	return b, nil
 }
+
+// DecodeHits decodes the hits into dest, a pointer to a slice of any type (e.g. *[]Movie) decoded with
+// encoding/json. Numbers are decoded as json.Number when dest has interface{} fields.
+// The hits of a response received from MeiliSearch are decoded from the raw response in a single step, changes
+// made to Hits by the caller, other than with DedupHits, are then ignored.
+func (r *SearchResponse) DecodeHits(dest interface{}) error {
+	data := []byte(r.rawHits)
+	if data == nil {
+		var err error
+		if data, err = json.Marshal(r.Hits); err != nil {
+			return err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(dest)
+}
+
+// FacetCounts returns the FacetsDistribution of the response as the number of matching documents per value,
+// per facet. The counts only include the hits kept by the RankingScoreThreshold of the request, if any, and are
+// exact only if ExhaustiveFacetsCount is true.
+func (r *SearchResponse) FacetCounts() (map[string]map[string]int64, error) {
+	counts := map[string]map[string]int64{}
+	if r.FacetsDistribution == nil {
+		return counts, nil
+	}
+
+	data, err := json.Marshal(r.FacetsDistribution)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &counts); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// DedupHits removes in place the hits having the same key as a previous hit, keyFunc computing the key of a hit
+// (e.g. its normalized title). The order of the hits is kept, the number of hits removed is returned.
+// NbHits is not changed, it still counts the duplicates.
+func (r *SearchResponse) DedupHits(keyFunc func(hit interface{}) string) int {
+	seen := make(map[string]bool, len(r.Hits))
+	hits := r.Hits[:0]
+	for _, hit := range r.Hits {
+		key := keyFunc(hit)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		hits = append(hits, hit)
+	}
+
+	removed := len(r.Hits) - len(hits)
+	for i := len(hits); i < len(r.Hits); i++ {
+		r.Hits[i] = nil
+	}
+	r.Hits = hits
+	if removed > 0 {
+		r.rawHits = nil
+	}
+	return removed
+}
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -99,7 +100,86 @@ func (v *Version) UnmarshalJSON(data []byte) error {
 func (v *Version) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(in *jlexer.Lexer, out *Update) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(in *jlexer.Lexer, out *UpdateKeyRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(out *jwriter.Writer, in UpdateKeyRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateKeyRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateKeyRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateKeyRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateKeyRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(in *jlexer.Lexer, out *Update) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -154,6 +234,16 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(in *jlexer.Lexer,
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.ProcessedAt).UnmarshalJSON(data))
 			}
+		case "batchUid":
+			if in.IsNull() {
+				in.Skip()
+				out.BatchUID = nil
+			} else {
+				if out.BatchUID == nil {
+					out.BatchUID = new(int64)
+				}
+				*out.BatchUID = int64(in.Int64())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -164,7 +254,7 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(out *jwriter.Writer, in Update) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(out *jwriter.Writer, in Update) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -220,33 +310,237 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(out *jwriter.Write
 		out.RawString(prefix)
 		out.Raw((in.ProcessedAt).MarshalJSON())
 	}
+	if in.BatchUID != nil {
+		const prefix string = ",\"batchUid\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.BatchUID))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Update) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Update) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo1(w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Update) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Update) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo1(l, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(in *jlexer.Lexer, out *TypoTolerance) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			if in.IsNull() {
+				in.Skip()
+				out.Enabled = nil
+			} else {
+				if out.Enabled == nil {
+					out.Enabled = new(bool)
+				}
+				*out.Enabled = bool(in.Bool())
+			}
+		case "minWordSizeForTypos":
+			if in.IsNull() {
+				in.Skip()
+				out.MinWordSizeForTypos = nil
+			} else {
+				if out.MinWordSizeForTypos == nil {
+					out.MinWordSizeForTypos = new(MinWordSizeForTypos)
+				}
+				(*out.MinWordSizeForTypos).UnmarshalEasyJSON(in)
+			}
+		case "disableOnWords":
+			if in.IsNull() {
+				in.Skip()
+				out.DisableOnWords = nil
+			} else {
+				if out.DisableOnWords == nil {
+					out.DisableOnWords = new([]string)
+				}
+				if in.IsNull() {
+					in.Skip()
+					*out.DisableOnWords = nil
+				} else {
+					in.Delim('[')
+					if *out.DisableOnWords == nil {
+						if !in.IsDelim(']') {
+							*out.DisableOnWords = make([]string, 0, 4)
+						} else {
+							*out.DisableOnWords = []string{}
+						}
+					} else {
+						*out.DisableOnWords = (*out.DisableOnWords)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v3 string
+						v3 = string(in.String())
+						*out.DisableOnWords = append(*out.DisableOnWords, v3)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+			}
+		case "disableOnAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.DisableOnAttributes = nil
+			} else {
+				if out.DisableOnAttributes == nil {
+					out.DisableOnAttributes = new([]string)
+				}
+				if in.IsNull() {
+					in.Skip()
+					*out.DisableOnAttributes = nil
+				} else {
+					in.Delim('[')
+					if *out.DisableOnAttributes == nil {
+						if !in.IsDelim(']') {
+							*out.DisableOnAttributes = make([]string, 0, 4)
+						} else {
+							*out.DisableOnAttributes = []string{}
+						}
+					} else {
+						*out.DisableOnAttributes = (*out.DisableOnAttributes)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v4 string
+						v4 = string(in.String())
+						*out.DisableOnAttributes = append(*out.DisableOnAttributes, v4)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(out *jwriter.Writer, in TypoTolerance) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Enabled != nil {
+		const prefix string = ",\"enabled\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(*in.Enabled))
+	}
+	if in.MinWordSizeForTypos != nil {
+		const prefix string = ",\"minWordSizeForTypos\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.MinWordSizeForTypos).MarshalEasyJSON(out)
+	}
+	if in.DisableOnWords != nil {
+		const prefix string = ",\"disableOnWords\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if *in.DisableOnWords == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range *in.DisableOnWords {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.DisableOnAttributes != nil {
+		const prefix string = ",\"disableOnAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if *in.DisableOnAttributes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range *in.DisableOnAttributes {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v8))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypoTolerance) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypoTolerance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypoTolerance) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypoTolerance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(in *jlexer.Lexer, out *StatsIndex) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer, out *StatsIndex) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -278,13 +572,47 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(in *jlexer.Lexer,
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v3 int64
-					v3 = int64(in.Int64())
-					(out.FieldsFrequency)[key] = v3
+					var v9 int64
+					v9 = int64(in.Int64())
+					(out.FieldsFrequency)[key] = v9
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "fieldDistribution":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FieldDistribution = make(map[string]int64)
+				} else {
+					out.FieldDistribution = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v10 int64
+					v10 = int64(in.Int64())
+					(out.FieldDistribution)[key] = v10
 					in.WantComma()
 				}
 				in.Delim('}')
 			}
+		case "lastUpdate":
+			if in.IsNull() {
+				in.Skip()
+				out.LastUpdate = nil
+			} else {
+				if out.LastUpdate == nil {
+					out.LastUpdate = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastUpdate).UnmarshalJSON(data))
+				}
+			}
+		case "rawDocumentDbSize":
+			out.RawDocumentDbSize = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -295,7 +623,7 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(out *jwriter.Writer, in StatsIndex) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Writer, in StatsIndex) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -316,47 +644,76 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(out *jwriter.Write
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v4First := true
-			for v4Name, v4Value := range in.FieldsFrequency {
-				if v4First {
-					v4First = false
+			v11First := true
+			for v11Name, v11Value := range in.FieldsFrequency {
+				if v11First {
+					v11First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v11Name))
+				out.RawByte(':')
+				out.Int64(int64(v11Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.FieldDistribution) != 0 {
+		const prefix string = ",\"fieldDistribution\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v12First := true
+			for v12Name, v12Value := range in.FieldDistribution {
+				if v12First {
+					v12First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v4Name))
+				out.String(string(v12Name))
 				out.RawByte(':')
-				out.Int64(int64(v4Value))
+				out.Int64(int64(v12Value))
 			}
 			out.RawByte('}')
 		}
 	}
+	if in.LastUpdate != nil {
+		const prefix string = ",\"lastUpdate\":"
+		out.RawString(prefix)
+		out.Raw((*in.LastUpdate).MarshalJSON())
+	}
+	if in.RawDocumentDbSize != 0 {
+		const prefix string = ",\"rawDocumentDbSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.RawDocumentDbSize))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v StatsIndex) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StatsIndex) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo2(w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StatsIndex) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StatsIndex) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo2(l, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(in *jlexer.Lexer, out *Stats) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo5(in *jlexer.Lexer, out *Stats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -390,9 +747,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(in *jlexer.Lexer,
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v5 StatsIndex
-					(v5).UnmarshalEasyJSON(in)
-					(out.Indexes)[key] = v5
+					var v13 StatsIndex
+					(v13).UnmarshalEasyJSON(in)
+					(out.Indexes)[key] = v13
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -407,7 +764,7 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(out *jwriter.Writer, in Stats) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo5(out *jwriter.Writer, in Stats) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -428,16 +785,16 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(out *jwriter.Write
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v6First := true
-			for v6Name, v6Value := range in.Indexes {
-				if v6First {
-					v6First = false
+			v14First := true
+			for v14Name, v14Value := range in.Indexes {
+				if v14First {
+					v14First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v6Name))
+				out.String(string(v14Name))
 				out.RawByte(':')
-				(v6Value).MarshalEasyJSON(out)
+				(v14Value).MarshalEasyJSON(out)
 			}
 			out.RawByte('}')
 		}
@@ -448,27 +805,27 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(out *jwriter.Write
 // MarshalJSON supports json.Marshaler interface
 func (v Stats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Stats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo3(w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Stats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Stats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo3(l, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo5(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer, out *Settings) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer, out *Settings) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -503,9 +860,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 					out.RankingRules = (out.RankingRules)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 string
-					v7 = string(in.String())
-					out.RankingRules = append(out.RankingRules, v7)
+					var v15 string
+					v15 = string(in.String())
+					out.RankingRules = append(out.RankingRules, v15)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -536,9 +893,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 					out.SearchableAttributes = (out.SearchableAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v8 string
-					v8 = string(in.String())
-					out.SearchableAttributes = append(out.SearchableAttributes, v8)
+					var v16 string
+					v16 = string(in.String())
+					out.SearchableAttributes = append(out.SearchableAttributes, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -559,9 +916,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 					out.DisplayedAttributes = (out.DisplayedAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v9 string
-					v9 = string(in.String())
-					out.DisplayedAttributes = append(out.DisplayedAttributes, v9)
+					var v17 string
+					v17 = string(in.String())
+					out.DisplayedAttributes = append(out.DisplayedAttributes, v17)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -582,9 +939,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 					out.StopWords = (out.StopWords)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.StopWords = append(out.StopWords, v10)
+					var v18 string
+					v18 = string(in.String())
+					out.StopWords = append(out.StopWords, v18)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -602,30 +959,30 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v11 []string
+					var v19 []string
 					if in.IsNull() {
 						in.Skip()
-						v11 = nil
+						v19 = nil
 					} else {
 						in.Delim('[')
-						if v11 == nil {
+						if v19 == nil {
 							if !in.IsDelim(']') {
-								v11 = make([]string, 0, 4)
+								v19 = make([]string, 0, 4)
 							} else {
-								v11 = []string{}
+								v19 = []string{}
 							}
 						} else {
-							v11 = (v11)[:0]
+							v19 = (v19)[:0]
 						}
 						for !in.IsDelim(']') {
-							var v12 string
-							v12 = string(in.String())
-							v11 = append(v11, v12)
+							var v20 string
+							v20 = string(in.String())
+							v19 = append(v19, v20)
 							in.WantComma()
 						}
 						in.Delim(']')
 					}
-					(out.Synonyms)[key] = v11
+					(out.Synonyms)[key] = v19
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -646,13 +1003,89 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 					out.AttributesForFaceting = (out.AttributesForFaceting)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 string
-					v13 = string(in.String())
-					out.AttributesForFaceting = append(out.AttributesForFaceting, v13)
+					var v21 string
+					v21 = string(in.String())
+					out.AttributesForFaceting = append(out.AttributesForFaceting, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "filterableAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.FilterableAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.FilterableAttributes == nil {
+					if !in.IsDelim(']') {
+						out.FilterableAttributes = make([]string, 0, 4)
+					} else {
+						out.FilterableAttributes = []string{}
+					}
+				} else {
+					out.FilterableAttributes = (out.FilterableAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 string
+					v22 = string(in.String())
+					out.FilterableAttributes = append(out.FilterableAttributes, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sortableAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.SortableAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.SortableAttributes == nil {
+					if !in.IsDelim(']') {
+						out.SortableAttributes = make([]string, 0, 4)
+					} else {
+						out.SortableAttributes = []string{}
+					}
+				} else {
+					out.SortableAttributes = (out.SortableAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 string
+					v23 = string(in.String())
+					out.SortableAttributes = append(out.SortableAttributes, v23)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "pagination":
+			if in.IsNull() {
+				in.Skip()
+				out.Pagination = nil
+			} else {
+				if out.Pagination == nil {
+					out.Pagination = new(Pagination)
+				}
+				(*out.Pagination).UnmarshalEasyJSON(in)
+			}
+		case "typoTolerance":
+			if in.IsNull() {
+				in.Skip()
+				out.TypoTolerance = nil
+			} else {
+				if out.TypoTolerance == nil {
+					out.TypoTolerance = new(TypoTolerance)
+				}
+				(*out.TypoTolerance).UnmarshalEasyJSON(in)
+			}
+		case "faceting":
+			if in.IsNull() {
+				in.Skip()
+				out.Faceting = nil
+			} else {
+				if out.Faceting == nil {
+					out.Faceting = new(Faceting)
+				}
+				(*out.Faceting).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -663,7 +1096,7 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Writer, in Settings) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Writer, in Settings) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -673,11 +1106,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Write
 		out.RawString(prefix[1:])
 		{
 			out.RawByte('[')
-			for v14, v15 := range in.RankingRules {
-				if v14 > 0 {
+			for v24, v25 := range in.RankingRules {
+				if v24 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v15))
+				out.String(string(v25))
 			}
 			out.RawByte(']')
 		}
@@ -702,11 +1135,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Write
 		}
 		{
 			out.RawByte('[')
-			for v16, v17 := range in.SearchableAttributes {
-				if v16 > 0 {
+			for v26, v27 := range in.SearchableAttributes {
+				if v26 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v17))
+				out.String(string(v27))
 			}
 			out.RawByte(']')
 		}
@@ -721,11 +1154,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Write
 		}
 		{
 			out.RawByte('[')
-			for v18, v19 := range in.DisplayedAttributes {
-				if v18 > 0 {
+			for v28, v29 := range in.DisplayedAttributes {
+				if v28 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v19))
+				out.String(string(v29))
 			}
 			out.RawByte(']')
 		}
@@ -740,11 +1173,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Write
 		}
 		{
 			out.RawByte('[')
-			for v20, v21 := range in.StopWords {
-				if v20 > 0 {
+			for v30, v31 := range in.StopWords {
+				if v30 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v21))
+				out.String(string(v31))
 			}
 			out.RawByte(']')
 		}
@@ -759,24 +1192,24 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Write
 		}
 		{
 			out.RawByte('{')
-			v22First := true
-			for v22Name, v22Value := range in.Synonyms {
-				if v22First {
-					v22First = false
+			v32First := true
+			for v32Name, v32Value := range in.Synonyms {
+				if v32First {
+					v32First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v22Name))
+				out.String(string(v32Name))
 				out.RawByte(':')
-				if v22Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+				if v32Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 					out.RawString("null")
 				} else {
 					out.RawByte('[')
-					for v23, v24 := range v22Value {
-						if v23 > 0 {
+					for v33, v34 := range v32Value {
+						if v33 > 0 {
 							out.RawByte(',')
 						}
-						out.String(string(v24))
+						out.String(string(v34))
 					}
 					out.RawByte(']')
 				}
@@ -794,225 +1227,110 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(out *jwriter.Write
 		}
 		{
 			out.RawByte('[')
-			for v25, v26 := range in.AttributesForFaceting {
-				if v25 > 0 {
+			for v35, v36 := range in.AttributesForFaceting {
+				if v35 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v26))
+				out.String(string(v36))
 			}
 			out.RawByte(']')
 		}
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Settings) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Settings) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo4(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Settings) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Settings) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo4(l, v)
-}
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo5(in *jlexer.Lexer, out *SearchResponse) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+	if len(in.FilterableAttributes) != 0 {
+		const prefix string = ",\"filterableAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		switch key {
-		case "hits":
-			if in.IsNull() {
-				in.Skip()
-				out.Hits = nil
-			} else {
-				in.Delim('[')
-				if out.Hits == nil {
-					if !in.IsDelim(']') {
-						out.Hits = make([]interface{}, 0, 4)
-					} else {
-						out.Hits = []interface{}{}
-					}
-				} else {
-					out.Hits = (out.Hits)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v27 interface{}
-					if m, ok := v27.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v27.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v27 = in.Interface()
-					}
-					out.Hits = append(out.Hits, v27)
-					in.WantComma()
+		{
+			out.RawByte('[')
+			for v37, v38 := range in.FilterableAttributes {
+				if v37 > 0 {
+					out.RawByte(',')
 				}
-				in.Delim(']')
-			}
-		case "nbHits":
-			out.NbHits = int64(in.Int64())
-		case "offset":
-			out.Offset = int64(in.Int64())
-		case "limit":
-			out.Limit = int64(in.Int64())
-		case "processingTimeMs":
-			out.ProcessingTimeMs = int64(in.Int64())
-		case "query":
-			out.Query = string(in.String())
-		case "facetsDistribution":
-			if m, ok := out.FacetsDistribution.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.FacetsDistribution.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.FacetsDistribution = in.Interface()
-			}
-		case "exhaustiveFacetsCount":
-			if m, ok := out.ExhaustiveFacetsCount.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.ExhaustiveFacetsCount.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.ExhaustiveFacetsCount = in.Interface()
+				out.String(string(v38))
 			}
-		default:
-			in.SkipRecursive()
+			out.RawByte(']')
 		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
 	}
-}
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo5(out *jwriter.Writer, in SearchResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"hits\":"
-		out.RawString(prefix[1:])
-		if in.Hits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+	if len(in.SortableAttributes) != 0 {
+		const prefix string = ",\"sortableAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
+			out.RawString(prefix)
+		}
+		{
 			out.RawByte('[')
-			for v28, v29 := range in.Hits {
-				if v28 > 0 {
+			for v39, v40 := range in.SortableAttributes {
+				if v39 > 0 {
 					out.RawByte(',')
 				}
-				if m, ok := v29.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v29.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v29))
-				}
+				out.String(string(v40))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"nbHits\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.NbHits))
-	}
-	{
-		const prefix string = ",\"offset\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Offset))
-	}
-	{
-		const prefix string = ",\"limit\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
-	}
-	{
-		const prefix string = ",\"processingTimeMs\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.ProcessingTimeMs))
-	}
-	{
-		const prefix string = ",\"query\":"
-		out.RawString(prefix)
-		out.String(string(in.Query))
+	if in.Pagination != nil {
+		const prefix string = ",\"pagination\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Pagination).MarshalEasyJSON(out)
 	}
-	if in.FacetsDistribution != nil {
-		const prefix string = ",\"facetsDistribution\":"
-		out.RawString(prefix)
-		if m, ok := in.FacetsDistribution.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.FacetsDistribution.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+	if in.TypoTolerance != nil {
+		const prefix string = ",\"typoTolerance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.Raw(json.Marshal(in.FacetsDistribution))
+			out.RawString(prefix)
 		}
+		(*in.TypoTolerance).MarshalEasyJSON(out)
 	}
-	if in.ExhaustiveFacetsCount != nil {
-		const prefix string = ",\"exhaustiveFacetsCount\":"
-		out.RawString(prefix)
-		if m, ok := in.ExhaustiveFacetsCount.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.ExhaustiveFacetsCount.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+	if in.Faceting != nil {
+		const prefix string = ",\"faceting\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.Raw(json.Marshal(in.ExhaustiveFacetsCount))
+			out.RawString(prefix)
 		}
+		(*in.Faceting).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SearchResponse) MarshalJSON() ([]byte, error) {
+func (v Settings) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo5(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo5(w, v)
+func (v Settings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchResponse) UnmarshalJSON(data []byte) error {
+func (v *Settings) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo5(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo5(l, v)
+func (v *Settings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer, out *SearchRequest) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(in *jlexer.Lexer, out *SearchRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1053,9 +1371,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer,
 					out.AttributesToRetrieve = (out.AttributesToRetrieve)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v30 string
-					v30 = string(in.String())
-					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v30)
+					var v41 string
+					v41 = string(in.String())
+					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v41)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1076,9 +1394,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer,
 					out.AttributesToCrop = (out.AttributesToCrop)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v31 string
-					v31 = string(in.String())
-					out.AttributesToCrop = append(out.AttributesToCrop, v31)
+					var v42 string
+					v42 = string(in.String())
+					out.AttributesToCrop = append(out.AttributesToCrop, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1101,9 +1419,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer,
 					out.AttributesToHighlight = (out.AttributesToHighlight)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v32 string
-					v32 = string(in.String())
-					out.AttributesToHighlight = append(out.AttributesToHighlight, v32)
+					var v43 string
+					v43 = string(in.String())
+					out.AttributesToHighlight = append(out.AttributesToHighlight, v43)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1128,9 +1446,9 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer,
 					out.FacetsDistribution = (out.FacetsDistribution)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v33 string
-					v33 = string(in.String())
-					out.FacetsDistribution = append(out.FacetsDistribution, v33)
+					var v44 string
+					v44 = string(in.String())
+					out.FacetsDistribution = append(out.FacetsDistribution, v44)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1145,6 +1463,65 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer,
 			}
 		case "PlaceholderSearch":
 			out.PlaceholderSearch = bool(in.Bool())
+		case "Filter":
+			out.Filter = string(in.String())
+		case "Sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]string, 0, 4)
+					} else {
+						out.Sort = []string{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v45 string
+					v45 = string(in.String())
+					out.Sort = append(out.Sort, v45)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "RankingScoreThreshold":
+			if in.IsNull() {
+				in.Skip()
+				out.RankingScoreThreshold = nil
+			} else {
+				if out.RankingScoreThreshold == nil {
+					out.RankingScoreThreshold = new(float64)
+				}
+				*out.RankingScoreThreshold = float64(in.Float64())
+			}
+		case "CountOnly":
+			out.CountOnly = bool(in.Bool())
+		case "ExtraQueryParams":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.ExtraQueryParams = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v46 string
+					v46 = string(in.String())
+					(out.ExtraQueryParams)[key] = v46
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "HighlightPreTag":
+			out.HighlightPreTag = string(in.String())
+		case "HighlightPostTag":
+			out.HighlightPostTag = string(in.String())
+		case "CropMarker":
+			out.CropMarker = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1155,7 +1532,7 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Writer, in SearchRequest) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo7(out *jwriter.Writer, in SearchRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1181,11 +1558,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v34, v35 := range in.AttributesToRetrieve {
-				if v34 > 0 {
+			for v47, v48 := range in.AttributesToRetrieve {
+				if v47 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v35))
+				out.String(string(v48))
 			}
 			out.RawByte(']')
 		}
@@ -1197,11 +1574,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v36, v37 := range in.AttributesToCrop {
-				if v36 > 0 {
+			for v49, v50 := range in.AttributesToCrop {
+				if v49 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v37))
+				out.String(string(v50))
 			}
 			out.RawByte(']')
 		}
@@ -1218,11 +1595,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v38, v39 := range in.AttributesToHighlight {
-				if v38 > 0 {
+			for v51, v52 := range in.AttributesToHighlight {
+				if v51 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v39))
+				out.String(string(v52))
 			}
 			out.RawByte(']')
 		}
@@ -1244,11 +1621,11 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Write
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v40, v41 := range in.FacetsDistribution {
-				if v40 > 0 {
+			for v53, v54 := range in.FacetsDistribution {
+				if v53 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v41))
+				out.String(string(v54))
 			}
 			out.RawByte(']')
 		}
@@ -1269,33 +1646,1866 @@ func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(out *jwriter.Write
 		out.RawString(prefix)
 		out.Bool(bool(in.PlaceholderSearch))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v SearchRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo6(w, v)
-}
+	{
+		const prefix string = ",\"Filter\":"
+		out.RawString(prefix)
+		out.String(string(in.Filter))
+	}
+	{
+		const prefix string = ",\"Sort\":"
+		out.RawString(prefix)
+		if in.Sort == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.Sort {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v56))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"RankingScoreThreshold\":"
+		out.RawString(prefix)
+		if in.RankingScoreThreshold == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.RankingScoreThreshold))
+		}
+	}
+	{
+		const prefix string = ",\"CountOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CountOnly))
+	}
+	{
+		const prefix string = ",\"ExtraQueryParams\":"
+		out.RawString(prefix)
+		if in.ExtraQueryParams == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v57First := true
+			for v57Name, v57Value := range in.ExtraQueryParams {
+				if v57First {
+					v57First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v57Name))
+				out.RawByte(':')
+				out.String(string(v57Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"HighlightPreTag\":"
+		out.RawString(prefix)
+		out.String(string(in.HighlightPreTag))
+	}
+	{
+		const prefix string = ",\"HighlightPostTag\":"
+		out.RawString(prefix)
+		out.String(string(in.HighlightPostTag))
+	}
+	{
+		const prefix string = ",\"CropMarker\":"
+		out.RawString(prefix)
+		out.String(string(in.CropMarker))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(in *jlexer.Lexer, out *RelevanceConfig) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "version":
+			out.Version = int(in.Int())
+		case "rankingRules":
+			if in.IsNull() {
+				in.Skip()
+				out.RankingRules = nil
+			} else {
+				in.Delim('[')
+				if out.RankingRules == nil {
+					if !in.IsDelim(']') {
+						out.RankingRules = make([]string, 0, 4)
+					} else {
+						out.RankingRules = []string{}
+					}
+				} else {
+					out.RankingRules = (out.RankingRules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 string
+					v58 = string(in.String())
+					out.RankingRules = append(out.RankingRules, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "synonyms":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Synonyms = make(map[string][]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v59 []string
+					if in.IsNull() {
+						in.Skip()
+						v59 = nil
+					} else {
+						in.Delim('[')
+						if v59 == nil {
+							if !in.IsDelim(']') {
+								v59 = make([]string, 0, 4)
+							} else {
+								v59 = []string{}
+							}
+						} else {
+							v59 = (v59)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v60 string
+							v60 = string(in.String())
+							v59 = append(v59, v60)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Synonyms)[key] = v59
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "stopWords":
+			if in.IsNull() {
+				in.Skip()
+				out.StopWords = nil
+			} else {
+				in.Delim('[')
+				if out.StopWords == nil {
+					if !in.IsDelim(']') {
+						out.StopWords = make([]string, 0, 4)
+					} else {
+						out.StopWords = []string{}
+					}
+				} else {
+					out.StopWords = (out.StopWords)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 string
+					v61 = string(in.String())
+					out.StopWords = append(out.StopWords, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "searchableAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.SearchableAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.SearchableAttributes == nil {
+					if !in.IsDelim(']') {
+						out.SearchableAttributes = make([]string, 0, 4)
+					} else {
+						out.SearchableAttributes = []string{}
+					}
+				} else {
+					out.SearchableAttributes = (out.SearchableAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v62 string
+					v62 = string(in.String())
+					out.SearchableAttributes = append(out.SearchableAttributes, v62)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo8(out *jwriter.Writer, in RelevanceConfig) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Version))
+	}
+	{
+		const prefix string = ",\"rankingRules\":"
+		out.RawString(prefix)
+		if in.RankingRules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v63, v64 := range in.RankingRules {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v64))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"synonyms\":"
+		out.RawString(prefix)
+		if in.Synonyms == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v65First := true
+			for v65Name, v65Value := range in.Synonyms {
+				if v65First {
+					v65First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v65Name))
+				out.RawByte(':')
+				if v65Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v66, v67 := range v65Value {
+						if v66 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v67))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"stopWords\":"
+		out.RawString(prefix)
+		if in.StopWords == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.StopWords {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v69))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"searchableAttributes\":"
+		out.RawString(prefix)
+		if in.SearchableAttributes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v70, v71 := range in.SearchableAttributes {
+				if v70 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v71))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RelevanceConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RelevanceConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RelevanceConfig) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RelevanceConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(in *jlexer.Lexer, out *PrimaryKey) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "primaryKey":
+			out.PrimaryKey = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo9(out *jwriter.Writer, in PrimaryKey) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"primaryKey\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PrimaryKey))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PrimaryKey) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PrimaryKey) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PrimaryKey) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PrimaryKey) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(in *jlexer.Lexer, out *Pagination) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "maxTotalHits":
+			out.MaxTotalHits = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo10(out *jwriter.Writer, in Pagination) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxTotalHits\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.MaxTotalHits))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pagination) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pagination) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pagination) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pagination) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(in *jlexer.Lexer, out *Name) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo11(out *jwriter.Writer, in Name) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Name) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Name) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Name) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Name) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(in *jlexer.Lexer, out *MinWordSizeForTypos) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oneTypo":
+			out.OneTypo = int64(in.Int64())
+		case "twoTypos":
+			out.TwoTypos = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo12(out *jwriter.Writer, in MinWordSizeForTypos) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.OneTypo != 0 {
+		const prefix string = ",\"oneTypo\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OneTypo))
+	}
+	if in.TwoTypos != 0 {
+		const prefix string = ",\"twoTypos\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.TwoTypos))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MinWordSizeForTypos) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MinWordSizeForTypos) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MinWordSizeForTypos) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MinWordSizeForTypos) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(in *jlexer.Lexer, out *MatchPosition) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = int64(in.Int64())
+		case "length":
+			out.Length = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo13(out *jwriter.Writer, in MatchPosition) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Start))
+	}
+	{
+		const prefix string = ",\"length\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Length))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchPosition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchPosition) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchPosition) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(in *jlexer.Lexer, out *ListDocumentsRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "attributesToRetrieve":
+			if in.IsNull() {
+				in.Skip()
+				out.AttributesToRetrieve = nil
+			} else {
+				in.Delim('[')
+				if out.AttributesToRetrieve == nil {
+					if !in.IsDelim(']') {
+						out.AttributesToRetrieve = make([]string, 0, 4)
+					} else {
+						out.AttributesToRetrieve = []string{}
+					}
+				} else {
+					out.AttributesToRetrieve = (out.AttributesToRetrieve)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 string
+					v72 = string(in.String())
+					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ids":
+			if in.IsNull() {
+				in.Skip()
+				out.IDs = nil
+			} else {
+				in.Delim('[')
+				if out.IDs == nil {
+					if !in.IsDelim(']') {
+						out.IDs = make([]string, 0, 4)
+					} else {
+						out.IDs = []string{}
+					}
+				} else {
+					out.IDs = (out.IDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 string
+					v73 = string(in.String())
+					out.IDs = append(out.IDs, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo14(out *jwriter.Writer, in ListDocumentsRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Offset))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Limit))
+	}
+	if len(in.AttributesToRetrieve) != 0 {
+		const prefix string = ",\"attributesToRetrieve\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v74, v75 := range in.AttributesToRetrieve {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v75))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.IDs) != 0 {
+		const prefix string = ",\"ids\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v76, v77 := range in.IDs {
+				if v76 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v77))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListDocumentsRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListDocumentsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListDocumentsRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListDocumentsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(in *jlexer.Lexer, out *Keys) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "public":
+			out.Public = string(in.String())
+		case "private":
+			out.Private = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo15(out *jwriter.Writer, in Keys) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Public != "" {
+		const prefix string = ",\"public\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Public))
+	}
+	if in.Private != "" {
+		const prefix string = ",\"private\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Private))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Keys) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Keys) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Keys) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Keys) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo16(in *jlexer.Lexer, out *Key) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uid":
+			out.UID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "key":
+			out.Key = string(in.String())
+		case "actions":
+			if in.IsNull() {
+				in.Skip()
+				out.Actions = nil
+			} else {
+				in.Delim('[')
+				if out.Actions == nil {
+					if !in.IsDelim(']') {
+						out.Actions = make([]string, 0, 4)
+					} else {
+						out.Actions = []string{}
+					}
+				} else {
+					out.Actions = (out.Actions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 string
+					v78 = string(in.String())
+					out.Actions = append(out.Actions, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "indexes":
+			if in.IsNull() {
+				in.Skip()
+				out.Indexes = nil
+			} else {
+				in.Delim('[')
+				if out.Indexes == nil {
+					if !in.IsDelim(']') {
+						out.Indexes = make([]string, 0, 4)
+					} else {
+						out.Indexes = []string{}
+					}
+				} else {
+					out.Indexes = (out.Indexes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 string
+					v79 = string(in.String())
+					out.Indexes = append(out.Indexes, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiresAt = nil
+			} else {
+				if out.ExpiresAt == nil {
+					out.ExpiresAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpiresAt).UnmarshalJSON(data))
+				}
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo16(out *jwriter.Writer, in Key) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.UID != "" {
+		const prefix string = ",\"uid\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.UID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	if in.Key != "" {
+		const prefix string = ",\"key\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"actions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Actions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Actions {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v81))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"indexes\":"
+		out.RawString(prefix)
+		if in.Indexes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v82, v83 := range in.Indexes {
+				if v82 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v83))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		if in.ExpiresAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpiresAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Key) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Key) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Key) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Key) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo16(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo17(in *jlexer.Lexer, out *Index) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "uid":
+			out.UID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "primaryKey":
+			out.PrimaryKey = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo17(out *jwriter.Writer, in Index) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.String(string(in.UID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	if in.PrimaryKey != "" {
+		const prefix string = ",\"primaryKey\":"
+		out.RawString(prefix)
+		out.String(string(in.PrimaryKey))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Index) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Index) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Index) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Index) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo17(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo18(in *jlexer.Lexer, out *HighlightSpan) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = int(in.Int())
+		case "end":
+			out.End = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo18(out *jwriter.Writer, in HighlightSpan) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Start))
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		out.Int(int(in.End))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HighlightSpan) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HighlightSpan) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HighlightSpan) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HighlightSpan) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo18(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo19(in *jlexer.Lexer, out *Health) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "health":
+			out.Health = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo19(out *jwriter.Writer, in Health) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"health\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Health))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Health) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Health) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Health) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Health) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo19(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo20(in *jlexer.Lexer, out *Faceting) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "maxValuesPerFacet":
+			out.MaxValuesPerFacet = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo20(out *jwriter.Writer, in Faceting) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxValuesPerFacet\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.MaxValuesPerFacet))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Faceting) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Faceting) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Faceting) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Faceting) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo20(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo21(in *jlexer.Lexer, out *FacetSearchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "facetHits":
+			if in.IsNull() {
+				in.Skip()
+				out.FacetHits = nil
+			} else {
+				in.Delim('[')
+				if out.FacetHits == nil {
+					if !in.IsDelim(']') {
+						out.FacetHits = make([]FacetHit, 0, 2)
+					} else {
+						out.FacetHits = []FacetHit{}
+					}
+				} else {
+					out.FacetHits = (out.FacetHits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v84 FacetHit
+					(v84).UnmarshalEasyJSON(in)
+					out.FacetHits = append(out.FacetHits, v84)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "facetQuery":
+			out.FacetQuery = string(in.String())
+		case "processingTimeMs":
+			out.ProcessingTimeMs = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo21(out *jwriter.Writer, in FacetSearchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"facetHits\":"
+		out.RawString(prefix[1:])
+		if in.FacetHits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.FacetHits {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				(v86).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"facetQuery\":"
+		out.RawString(prefix)
+		out.String(string(in.FacetQuery))
+	}
+	{
+		const prefix string = ",\"processingTimeMs\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ProcessingTimeMs))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FacetSearchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FacetSearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FacetSearchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FacetSearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo21(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo22(in *jlexer.Lexer, out *FacetSearchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "facetName":
+			out.FacetName = string(in.String())
+		case "facetQuery":
+			out.FacetQuery = string(in.String())
+		case "q":
+			out.Query = string(in.String())
+		case "filter":
+			if m, ok := out.Filter.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Filter.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Filter = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo22(out *jwriter.Writer, in FacetSearchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"facetName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FacetName))
+	}
+	if in.FacetQuery != "" {
+		const prefix string = ",\"facetQuery\":"
+		out.RawString(prefix)
+		out.String(string(in.FacetQuery))
+	}
+	if in.Query != "" {
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	if in.Filter != nil {
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		if m, ok := in.Filter.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Filter.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Filter))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FacetSearchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FacetSearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FacetSearchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FacetSearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo22(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo23(in *jlexer.Lexer, out *FacetHit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "value":
+			out.Value = string(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo23(out *jwriter.Writer, in FacetHit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FacetHit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FacetHit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FacetHit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FacetHit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo23(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo24(in *jlexer.Lexer, out *Dump) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uid":
+			out.UID = string(in.String())
+		case "status":
+			out.Status = DumpStatus(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "startedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartedAt).UnmarshalJSON(data))
+			}
+		case "finishedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.FinishedAt = nil
+			} else {
+				if out.FinishedAt == nil {
+					out.FinishedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.FinishedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo24(out *jwriter.Writer, in Dump) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"startedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.StartedAt).MarshalJSON())
+	}
+	if in.FinishedAt != nil {
+		const prefix string = ",\"finishedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.FinishedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Dump) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Dump) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo24(w, v)
+}
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchRequest) UnmarshalJSON(data []byte) error {
+func (v *Dump) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo6(l, v)
+func (v *Dump) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo24(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(in *jlexer.Lexer, out *PrimaryKey) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo25(in *jlexer.Lexer, out *DiagnosticsReport) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1314,8 +3524,33 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "primaryKey":
-			out.PrimaryKey = string(in.String())
+		case "ok":
+			out.OK = bool(in.Bool())
+		case "version":
+			out.Version = string(in.String())
+		case "checks":
+			if in.IsNull() {
+				in.Skip()
+				out.Checks = nil
+			} else {
+				in.Delim('[')
+				if out.Checks == nil {
+					if !in.IsDelim(']') {
+						out.Checks = make([]DiagnosticCheck, 0, 1)
+					} else {
+						out.Checks = []DiagnosticCheck{}
+					}
+				} else {
+					out.Checks = (out.Checks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 DiagnosticCheck
+					(v87).UnmarshalEasyJSON(in)
+					out.Checks = append(out.Checks, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1326,42 +3561,157 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo7(out *jwriter.Writer, in PrimaryKey) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo25(out *jwriter.Writer, in DiagnosticsReport) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"primaryKey\":"
+		const prefix string = ",\"ok\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.PrimaryKey))
+		out.Bool(bool(in.OK))
+	}
+	if in.Version != "" {
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.String(string(in.Version))
+	}
+	{
+		const prefix string = ",\"checks\":"
+		out.RawString(prefix)
+		if in.Checks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v88, v89 := range in.Checks {
+				if v88 > 0 {
+					out.RawByte(',')
+				}
+				(v89).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PrimaryKey) MarshalJSON() ([]byte, error) {
+func (v DiagnosticsReport) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo7(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PrimaryKey) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo7(w, v)
+func (v DiagnosticsReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PrimaryKey) UnmarshalJSON(data []byte) error {
+func (v *DiagnosticsReport) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PrimaryKey) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo7(l, v)
+func (v *DiagnosticsReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo25(l, v)
+}
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo26(in *jlexer.Lexer, out *DiagnosticCheck) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "ok":
+			out.OK = bool(in.Bool())
+		case "latency":
+			out.Latency = time.Duration(in.Int64())
+		case "statusCode":
+			out.StatusCode = int(in.Int())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo26(out *jwriter.Writer, in DiagnosticCheck) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"ok\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OK))
+	}
+	{
+		const prefix string = ",\"latency\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Latency))
+	}
+	if in.StatusCode != 0 {
+		const prefix string = ",\"statusCode\":"
+		out.RawString(prefix)
+		out.Int(int(in.StatusCode))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiagnosticCheck) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiagnosticCheck) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiagnosticCheck) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiagnosticCheck) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo26(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(in *jlexer.Lexer, out *Name) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo27(in *jlexer.Lexer, out *CreateKeyRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1382,6 +3732,66 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(in *jlexer.Lexer,
 		switch key {
 		case "name":
 			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "actions":
+			if in.IsNull() {
+				in.Skip()
+				out.Actions = nil
+			} else {
+				in.Delim('[')
+				if out.Actions == nil {
+					if !in.IsDelim(']') {
+						out.Actions = make([]string, 0, 4)
+					} else {
+						out.Actions = []string{}
+					}
+				} else {
+					out.Actions = (out.Actions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v90 string
+					v90 = string(in.String())
+					out.Actions = append(out.Actions, v90)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "indexes":
+			if in.IsNull() {
+				in.Skip()
+				out.Indexes = nil
+			} else {
+				in.Delim('[')
+				if out.Indexes == nil {
+					if !in.IsDelim(']') {
+						out.Indexes = make([]string, 0, 4)
+					} else {
+						out.Indexes = []string{}
+					}
+				} else {
+					out.Indexes = (out.Indexes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 string
+					v91 = string(in.String())
+					out.Indexes = append(out.Indexes, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiresAt = nil
+			} else {
+				if out.ExpiresAt == nil {
+					out.ExpiresAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpiresAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1391,43 +3801,100 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(in *jlexer.Lexer,
 	if isTopLevel {
 		in.Consumed()
 	}
-}
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo8(out *jwriter.Writer, in Name) {
-	out.RawByte('{')
-	first := true
-	_ = first
+}
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo27(out *jwriter.Writer, in CreateKeyRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"actions\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Actions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Actions {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v93))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"indexes\":"
+		out.RawString(prefix)
+		if in.Indexes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v94, v95 := range in.Indexes {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v95))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		if in.ExpiresAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpiresAt).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Name) MarshalJSON() ([]byte, error) {
+func (v CreateKeyRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo8(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Name) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo8(w, v)
+func (v CreateKeyRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Name) UnmarshalJSON(data []byte) error {
+func (v *CreateKeyRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Name) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo8(l, v)
+func (v *CreateKeyRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo27(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(in *jlexer.Lexer, out *ListDocumentsRequest) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo28(in *jlexer.Lexer, out *CreateIndexResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1446,33 +3913,32 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "offset":
-			out.Offset = int64(in.Int64())
-		case "limit":
-			out.Limit = int64(in.Int64())
-		case "attributesToRetrieve":
+		case "name":
+			out.Name = string(in.String())
+		case "uid":
+			out.UID = string(in.String())
+		case "updateID":
+			out.UpdateID = int64(in.Int64())
+		case "taskUid":
 			if in.IsNull() {
 				in.Skip()
-				out.AttributesToRetrieve = nil
+				out.TaskUID = nil
 			} else {
-				in.Delim('[')
-				if out.AttributesToRetrieve == nil {
-					if !in.IsDelim(']') {
-						out.AttributesToRetrieve = make([]string, 0, 4)
-					} else {
-						out.AttributesToRetrieve = []string{}
-					}
-				} else {
-					out.AttributesToRetrieve = (out.AttributesToRetrieve)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v42 string
-					v42 = string(in.String())
-					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v42)
-					in.WantComma()
+				if out.TaskUID == nil {
+					out.TaskUID = new(int64)
 				}
-				in.Delim(']')
+				*out.TaskUID = int64(in.Int64())
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
 			}
+		case "primaryKey":
+			out.PrimaryKey = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1483,72 +3949,72 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo9(out *jwriter.Writer, in ListDocumentsRequest) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo28(out *jwriter.Writer, in CreateIndexResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Offset != 0 {
-		const prefix string = ",\"offset\":"
-		first = false
+	{
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Offset))
+		out.String(string(in.Name))
 	}
-	if in.Limit != 0 {
-		const prefix string = ",\"limit\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int64(int64(in.Limit))
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.String(string(in.UID))
 	}
-	if len(in.AttributesToRetrieve) != 0 {
-		const prefix string = ",\"attributesToRetrieve\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v43, v44 := range in.AttributesToRetrieve {
-				if v43 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v44))
-			}
-			out.RawByte(']')
-		}
+	if in.UpdateID != 0 {
+		const prefix string = ",\"updateID\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.UpdateID))
+	}
+	if in.TaskUID != nil {
+		const prefix string = ",\"taskUid\":"
+		out.RawString(prefix)
+		out.Int64(int64(*in.TaskUID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	if in.PrimaryKey != "" {
+		const prefix string = ",\"primaryKey\":"
+		out.RawString(prefix)
+		out.String(string(in.PrimaryKey))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ListDocumentsRequest) MarshalJSON() ([]byte, error) {
+func (v CreateIndexResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo9(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ListDocumentsRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo9(w, v)
+func (v CreateIndexResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ListDocumentsRequest) UnmarshalJSON(data []byte) error {
+func (v *CreateIndexResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ListDocumentsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo9(l, v)
+func (v *CreateIndexResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo28(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(in *jlexer.Lexer, out *Keys) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo29(in *jlexer.Lexer, out *CreateIndexRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1567,10 +4033,12 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "public":
-			out.Public = string(in.String())
-		case "private":
-			out.Private = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "uid":
+			out.UID = string(in.String())
+		case "primaryKey":
+			out.PrimaryKey = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1581,53 +4049,63 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo10(out *jwriter.Writer, in Keys) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo29(out *jwriter.Writer, in CreateIndexRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Public != "" {
-		const prefix string = ",\"public\":"
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Public))
+		out.String(string(in.Name))
 	}
-	if in.Private != "" {
-		const prefix string = ",\"private\":"
+	if in.UID != "" {
+		const prefix string = ",\"uid\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Private))
+		out.String(string(in.UID))
+	}
+	if in.PrimaryKey != "" {
+		const prefix string = ",\"primaryKey\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PrimaryKey))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Keys) MarshalJSON() ([]byte, error) {
+func (v CreateIndexRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo10(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Keys) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo10(w, v)
+func (v CreateIndexRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Keys) UnmarshalJSON(data []byte) error {
+func (v *CreateIndexRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Keys) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo10(l, v)
+func (v *CreateIndexRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo29(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(in *jlexer.Lexer, out *Index) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo30(in *jlexer.Lexer, out *BatchesResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1646,20 +4124,53 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "uid":
-			out.UID = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]Batch, 0, 0)
+					} else {
+						out.Results = []Batch{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v96 Batch
+					(v96).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v96)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "total":
+			out.Total = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "from":
+			if in.IsNull() {
+				in.Skip()
+				out.From = nil
+			} else {
+				if out.From == nil {
+					out.From = new(int64)
+				}
+				*out.From = int64(in.Int64())
+			}
+		case "next":
+			if in.IsNull() {
+				in.Skip()
+				out.Next = nil
+			} else {
+				if out.Next == nil {
+					out.Next = new(int64)
+				}
+				*out.Next = int64(in.Int64())
 			}
-		case "primaryKey":
-			out.PrimaryKey = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1670,62 +4181,81 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo11(out *jwriter.Writer, in Index) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo30(out *jwriter.Writer, in BatchesResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"results\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v97, v98 := range in.Results {
+				if v97 > 0 {
+					out.RawByte(',')
+				}
+				(v98).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"uid\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		out.String(string(in.UID))
+		out.Int64(int64(in.Total))
 	}
 	{
-		const prefix string = ",\"createdAt\":"
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Int64(int64(in.Limit))
 	}
 	{
-		const prefix string = ",\"updatedAt\":"
+		const prefix string = ",\"from\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		if in.From == nil {
+			out.RawString("null")
+		} else {
+			out.Int64(int64(*in.From))
+		}
 	}
-	if in.PrimaryKey != "" {
-		const prefix string = ",\"primaryKey\":"
+	{
+		const prefix string = ",\"next\":"
 		out.RawString(prefix)
-		out.String(string(in.PrimaryKey))
+		if in.Next == nil {
+			out.RawString("null")
+		} else {
+			out.Int64(int64(*in.Next))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Index) MarshalJSON() ([]byte, error) {
+func (v BatchesResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo11(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Index) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo11(w, v)
+func (v BatchesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Index) UnmarshalJSON(data []byte) error {
+func (v *BatchesResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Index) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo11(l, v)
+func (v *BatchesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo30(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(in *jlexer.Lexer, out *Health) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo31(in *jlexer.Lexer, out *BatchStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1744,8 +4274,56 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "health":
-			out.Health = bool(in.Bool())
+		case "totalNbTasks":
+			out.TotalNbTasks = int64(in.Int64())
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Status = make(map[string]int64)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v99 int64
+					v99 = int64(in.Int64())
+					(out.Status)[key] = v99
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "types":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Types = make(map[string]int64)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v100 int64
+					v100 = int64(in.Int64())
+					(out.Types)[key] = v100
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "indexUids":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.IndexUIDs = make(map[string]int64)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v101 int64
+					v101 = int64(in.Int64())
+					(out.IndexUIDs)[key] = v101
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1756,42 +4334,105 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo12(out *jwriter.Writer, in Health) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo31(out *jwriter.Writer, in BatchStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"health\":"
+		const prefix string = ",\"totalNbTasks\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Health))
+		out.Int64(int64(in.TotalNbTasks))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		if in.Status == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v102First := true
+			for v102Name, v102Value := range in.Status {
+				if v102First {
+					v102First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v102Name))
+				out.RawByte(':')
+				out.Int64(int64(v102Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"types\":"
+		out.RawString(prefix)
+		if in.Types == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v103First := true
+			for v103Name, v103Value := range in.Types {
+				if v103First {
+					v103First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v103Name))
+				out.RawByte(':')
+				out.Int64(int64(v103Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"indexUids\":"
+		out.RawString(prefix)
+		if in.IndexUIDs == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v104First := true
+			for v104Name, v104Value := range in.IndexUIDs {
+				if v104First {
+					v104First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v104Name))
+				out.RawByte(':')
+				out.Int64(int64(v104Value))
+			}
+			out.RawByte('}')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Health) MarshalJSON() ([]byte, error) {
+func (v BatchStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo12(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo31(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Health) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo12(w, v)
+func (v BatchStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo31(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Health) UnmarshalJSON(data []byte) error {
+func (v *BatchStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo31(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Health) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo12(l, v)
+func (v *BatchStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo31(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(in *jlexer.Lexer, out *CreateIndexResponse) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo32(in *jlexer.Lexer, out *BatchResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1810,22 +4451,33 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "uid":
-			out.UID = string(in.String())
-		case "updateID":
-			out.UpdateID = int64(in.Int64())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "outcomes":
+			if in.IsNull() {
+				in.Skip()
+				out.Outcomes = nil
+			} else {
+				in.Delim('[')
+				if out.Outcomes == nil {
+					if !in.IsDelim(']') {
+						out.Outcomes = make([]BatchOutcome, 0, 1)
+					} else {
+						out.Outcomes = []BatchOutcome{}
+					}
+				} else {
+					out.Outcomes = (out.Outcomes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v105 BatchOutcome
+					(v105).UnmarshalEasyJSON(in)
+					out.Outcomes = append(out.Outcomes, v105)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "primaryKey":
-			out.PrimaryKey = string(in.String())
+		case "succeeded":
+			out.Succeeded = int(in.Int())
+		case "failed":
+			out.Failed = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1836,67 +4488,63 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo13(out *jwriter.Writer, in CreateIndexResponse) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo32(out *jwriter.Writer, in BatchResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"outcomes\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"uid\":"
-		out.RawString(prefix)
-		out.String(string(in.UID))
-	}
-	if in.UpdateID != 0 {
-		const prefix string = ",\"updateID\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.UpdateID))
+		if in.Outcomes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v106, v107 := range in.Outcomes {
+				if v106 > 0 {
+					out.RawByte(',')
+				}
+				(v107).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"createdAt\":"
+		const prefix string = ",\"succeeded\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Int(int(in.Succeeded))
 	}
 	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	if in.PrimaryKey != "" {
-		const prefix string = ",\"primaryKey\":"
+		const prefix string = ",\"failed\":"
 		out.RawString(prefix)
-		out.String(string(in.PrimaryKey))
+		out.Int(int(in.Failed))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateIndexResponse) MarshalJSON() ([]byte, error) {
+func (v BatchResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo13(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateIndexResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo13(w, v)
+func (v BatchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateIndexResponse) UnmarshalJSON(data []byte) error {
+func (v *BatchResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateIndexResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo13(l, v)
+func (v *BatchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo32(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(in *jlexer.Lexer, out *CreateIndexRequest) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo33(in *jlexer.Lexer, out *BatchOutcome) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1915,12 +4563,12 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "uid":
-			out.UID = string(in.String())
-		case "primaryKey":
-			out.PrimaryKey = string(in.String())
+		case "updateId":
+			out.UpdateID = int64(in.Int64())
+		case "status":
+			out.Status = UpdateStatus(in.String())
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1931,63 +4579,52 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo14(out *jwriter.Writer, in CreateIndexRequest) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo33(out *jwriter.Writer, in BatchOutcome) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Name != "" {
-		const prefix string = ",\"name\":"
-		first = false
+	{
+		const prefix string = ",\"updateId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Int64(int64(in.UpdateID))
 	}
-	if in.UID != "" {
-		const prefix string = ",\"uid\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.UID))
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
 	}
-	if in.PrimaryKey != "" {
-		const prefix string = ",\"primaryKey\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.PrimaryKey))
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateIndexRequest) MarshalJSON() ([]byte, error) {
+func (v BatchOutcome) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo14(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateIndexRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo14(w, v)
+func (v BatchOutcome) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateIndexRequest) UnmarshalJSON(data []byte) error {
+func (v *BatchOutcome) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateIndexRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo14(l, v)
+func (v *BatchOutcome) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo33(l, v)
 }
-func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(in *jlexer.Lexer, out *AsyncUpdateID) {
+func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo34(in *jlexer.Lexer, out *Batch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2006,8 +4643,50 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "updateId":
-			out.UpdateID = int64(in.Int64())
+		case "uid":
+			out.UID = int64(in.Int64())
+		case "details":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Details = make(Unknown)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v108 interface{}
+					if m, ok := v108.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v108.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v108 = in.Interface()
+					}
+					(out.Details)[key] = v108
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "stats":
+			(out.Stats).UnmarshalEasyJSON(in)
+		case "duration":
+			out.Duration = string(in.String())
+		case "startedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartedAt).UnmarshalJSON(data))
+			}
+		case "finishedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.FinishedAt = nil
+			} else {
+				if out.FinishedAt == nil {
+					out.FinishedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.FinishedAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2018,38 +4697,89 @@ func easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo15(out *jwriter.Writer, in AsyncUpdateID) {
+func easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo34(out *jwriter.Writer, in Batch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"updateId\":"
+		const prefix string = ",\"uid\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.UpdateID))
+		out.Int64(int64(in.UID))
+	}
+	{
+		const prefix string = ",\"details\":"
+		out.RawString(prefix)
+		if in.Details == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v109First := true
+			for v109Name, v109Value := range in.Details {
+				if v109First {
+					v109First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v109Name))
+				out.RawByte(':')
+				if m, ok := v109Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v109Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v109Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"stats\":"
+		out.RawString(prefix)
+		(in.Stats).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.String(string(in.Duration))
+	}
+	{
+		const prefix string = ",\"startedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.StartedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"finishedAt\":"
+		out.RawString(prefix)
+		if in.FinishedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.FinishedAt).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AsyncUpdateID) MarshalJSON() ([]byte, error) {
+func (v Batch) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo15(&w, v)
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AsyncUpdateID) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo15(w, v)
+func (v Batch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComSenyast4745MeilisearchGo34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AsyncUpdateID) UnmarshalJSON(data []byte) error {
+func (v *Batch) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(&r, v)
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AsyncUpdateID) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo15(l, v)
+func (v *Batch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComSenyast4745MeilisearchGo34(l, v)
 }
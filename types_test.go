@@ -0,0 +1,227 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncUpdateID_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		want     int64
+		wantTask bool
+	}{
+		{name: "updateId", data: `{"updateId":1}`, want: 1},
+		{name: "updateID", data: `{"updateID":2}`, want: 2},
+		{name: "uid", data: `{"uid":3,"indexUid":"movies","status":"enqueued"}`, want: 3, wantTask: true},
+		{name: "taskUid", data: `{"taskUid":4,"indexUid":"movies","status":"enqueued","type":"documentAdditionOrUpdate"}`, want: 4, wantTask: true},
+		{name: "taskUid takes precedence", data: `{"taskUid":5,"uid":6}`, want: 5, wantTask: true},
+		{name: "no id", data: `{}`, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got AsyncUpdateID
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.want, got.UpdateID)
+			assert.Equal(t, tt.wantTask, got.IsTask)
+		})
+	}
+
+	var got AsyncUpdateID
+	if err := json.Unmarshal([]byte(`{"taskUid":"4"}`), &got); err == nil {
+		t.Fatal("a string id should not be accepted")
+	}
+}
+
+func TestRawType_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{"id":1}`)
+
+	var raw RawType
+	if err := raw.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	copy(data, `{"id":2}`)
+	assert.Equal(t, `{"id":1}`, string(raw), "the data must be copied")
+
+	first := raw
+	if err := raw.UnmarshalJSON([]byte(`{"id":3}`)); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, `{"id":3}`, string(raw))
+	assert.Equal(t, `{"id":1}`, string(first), "a value copied out before must not be overwritten")
+}
+
+func TestSearchResponse_DecodeHits(t *testing.T) {
+	var resp SearchResponse
+	if err := json.Unmarshal([]byte(`{"hits":[{"book_id":123,"title":"Pride and Prejudice","rating":4.5},{"book_id":456,"title":"Le Petit Prince","extra":{"pages":96}}],"nbHits":2}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	type book struct {
+		BookID int64                  `json:"book_id"`
+		Title  string                 `json:"title"`
+		Rating float64                `json:"rating"`
+		Extra  map[string]interface{} `json:"extra"`
+	}
+	var books []book
+	if err := resp.DecodeHits(&books); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []book{
+		{BookID: 123, Title: "Pride and Prejudice", Rating: 4.5},
+		{BookID: 456, Title: "Le Petit Prince", Extra: map[string]interface{}{"pages": json.Number("96")}},
+	}, books)
+
+	var wrong []struct {
+		Title int `json:"title"`
+	}
+	if err := resp.DecodeHits(&wrong); err == nil {
+		t.Fatal("decoding into a wrong type should fail")
+	}
+}
+
+func TestSearchResponse_DecodeHitsRaw(t *testing.T) {
+	c := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"hits":[{"sku":9007199254740993,"name":"Chocolate"},{"sku":2,"name":"Candy"}],` +
+			`"nbHits":2,"offset":0,"limit":20,"query":"c"}`))
+	})
+
+	resp, err := c.Search("products").Search(SearchRequest{Query: "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, resp.Hits, 2)
+	assert.Equal(t, int64(2), resp.NbHits)
+	assert.Equal(t, "c", resp.Query)
+
+	type product struct {
+		SKU  int64  `json:"sku"`
+		Name string `json:"name"`
+	}
+	var products []product
+	if err := resp.DecodeHits(&products); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []product{{SKU: 9007199254740993, Name: "Chocolate"}, {SKU: 2, Name: "Candy"}}, products,
+		"the hits must be decoded from the raw response, without a round trip through float64")
+
+	resp.DedupHits(func(hit interface{}) string { return "same" })
+	products = nil
+	if err := resp.DecodeHits(&products); err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, products, 1, "the hits removed by DedupHits must not be decoded")
+
+	var empty SearchResponse
+	if err := json.Unmarshal([]byte(`{"hits":null,"nbHits":0}`), &empty); err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, empty.Hits)
+}
+
+func TestSearchResponse_HitsCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantCount int64
+		wantExact bool
+	}{
+		{
+			name:      "estimated",
+			data:      `{"hits":[],"query":"a","limit":20,"offset":0,"estimatedTotalHits":1000}`,
+			wantCount: 1000,
+		},
+		{
+			name:      "exact",
+			data:      `{"hits":[],"query":"a","hitsPerPage":20,"page":1,"totalPages":3,"totalHits":42}`,
+			wantCount: 42,
+			wantExact: true,
+		},
+		{
+			name:      "exact without any hit",
+			data:      `{"hits":[],"query":"a","hitsPerPage":0,"page":1,"totalPages":0,"totalHits":0}`,
+			wantCount: 0,
+			wantExact: true,
+		},
+		{
+			name:      "legacy exhaustive",
+			data:      `{"hits":[],"nbHits":7,"exhaustiveNbHits":true}`,
+			wantCount: 7,
+			wantExact: true,
+		},
+		{
+			name:      "legacy estimated",
+			data:      `{"hits":[],"nbHits":7,"exhaustiveNbHits":false}`,
+			wantCount: 7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp SearchResponse
+			if err := json.Unmarshal([]byte(tt.data), &resp); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tt.wantCount, resp.HitsCount())
+			assert.Equal(t, tt.wantExact, resp.ExhaustiveHitsCount)
+		})
+	}
+}
+
+func TestSearchResponse_FacetCounts(t *testing.T) {
+	resp := SearchResponse{}
+	counts, err := resp.FacetCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, counts)
+
+	if err := json.Unmarshal([]byte(`{"facetsDistribution":{"genre":{"fantasy":3,"romance":0}}}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, resp.ExhaustiveFacetsCount)
+	counts, err = resp.FacetCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]map[string]int64{"genre": {"fantasy": 3, "romance": 0}}, counts)
+
+	resp.FacetsDistribution = map[string]interface{}{"genre": "fantasy"}
+	_, err = resp.FacetCounts()
+	assert.Error(t, err)
+}
+
+func TestSearchResponse_DedupHits(t *testing.T) {
+	resp := SearchResponse{}
+	if err := json.Unmarshal([]byte(`{"hits":[
+		{"id":1,"title":"Le Petit Prince"},
+		{"id":2,"title":"le petit prince "},
+		{"id":3,"title":"Pride and Prejudice"},
+		{"id":4,"title":"LE PETIT PRINCE"},
+		{"id":5,"title":"Pride and Prejudice"},
+		{"id":6,"title":"Alice In Wonderland"}
+	],"nbHits":6}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	title := func(hit interface{}) string {
+		return strings.ToLower(strings.TrimSpace(hit.(map[string]interface{})["title"].(string)))
+	}
+	assert.Equal(t, 3, resp.DedupHits(title))
+
+	ids := make([]float64, 0, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		ids = append(ids, hit.(map[string]interface{})["id"].(float64))
+	}
+	assert.Equal(t, []float64{1, 3, 6}, ids)
+	assert.Equal(t, int64(6), resp.NbHits)
+
+	assert.Equal(t, 0, resp.DedupHits(title))
+	assert.Len(t, resp.Hits, 3)
+}